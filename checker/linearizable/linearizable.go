@@ -0,0 +1,219 @@
+// Package linearizable decides whether a history of operations is
+// linearizable with respect to a sequential model, using the Wing and Gong
+// search with Lowe's memoization of visited states, as Knossos and Porcupine
+// do. When a history is not linearizable it also finds a minimal window: a
+// smallest set of operations from it that is not linearizable on its own.
+package linearizable
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Shresth72/go_gRPC_tester/history"
+)
+
+// never is the return time of operations whose outcome is unknown. They may
+// take effect at any point after their call, or not at all.
+const never = time.Duration(math.MaxInt64)
+
+// Operation is one call as the checker sees it: the invocation's input and,
+// when the call completed ok, its output.
+type Operation struct {
+	// Index is the position of the invocation in the recorded history.
+	Index   int
+	Process int
+	F       string
+	Input   interface{}
+	Output  interface{}
+	// Known reports whether Output was observed. Operations that timed out
+	// or crashed have no known output and may match any.
+	Known  bool
+	Call   time.Duration
+	Return time.Duration
+}
+
+func (op Operation) String() string {
+	out, ret := "?", "never"
+	if op.Known {
+		out = canonical(op.Output)
+	}
+	if op.Return != never {
+		ret = op.Return.String()
+	}
+	return fmt.Sprintf("#%d process %d %s %s -> %s [%s, %s]", op.Index, op.Process, op.F, canonical(op.Input), out, op.Call, ret)
+}
+
+// Model is a sequential specification. Step applies op to state and reports
+// whether op's output is possible from that state, along with the state
+// afterwards. States must be comparable with ==.
+type Model struct {
+	Name string
+	Init func() interface{}
+	Step func(state interface{}, op Operation) (bool, interface{})
+	// Partition, if set, splits the history into independent parts, such as
+	// the keys of a key-value store, which are checked separately.
+	Partition func(op Operation) string
+}
+
+// Result is the outcome of a check. For a history that is not linearizable,
+// Partition names the part that failed and Window holds a minimal set of its
+// operations that is not linearizable on its own, ordered by call time.
+// Unknown is set instead when the search for Partition gave up, which leaves
+// Window empty.
+type Result struct {
+	Valid     bool
+	Unknown   bool
+	Partition string
+	Window    []Operation
+}
+
+// Operations pairs the invocations in ops with their completions. Failed
+// operations are left out, since they had no effect; operations that ended
+// with info or never completed have an unknown outcome.
+func Operations(ops []history.Op) []Operation {
+	var out []Operation
+	failed := make(map[int]bool)
+	pending := make(map[int]int)
+
+	for _, op := range ops {
+		switch op.Type {
+		case history.Invoke:
+			pending[op.Process] = len(out)
+			out = append(out, Operation{
+				Index:   op.Index,
+				Process: op.Process,
+				F:       op.F,
+				Input:   op.Value,
+				Call:    op.Time,
+				Return:  never,
+			})
+		case history.OK:
+			if i, ok := pending[op.Process]; ok {
+				out[i].Output, out[i].Known, out[i].Return = op.Value, true, op.Time
+				delete(pending, op.Process)
+			}
+		case history.Fail:
+			if i, ok := pending[op.Process]; ok {
+				failed[i] = true
+				delete(pending, op.Process)
+			}
+		case history.Info:
+			delete(pending, op.Process)
+		}
+	}
+
+	kept := out[:0]
+	for i, op := range out {
+		if !failed[i] {
+			kept = append(kept, op)
+		}
+	}
+	return kept
+}
+
+// Check decides whether the recorded history is linearizable under model.
+func Check(model Model, ops []history.Op) *Result {
+	return CheckOperations(model, Operations(ops))
+}
+
+// CheckOperations decides whether ops are linearizable under model.
+func CheckOperations(model Model, ops []Operation) *Result {
+	parts := map[string][]Operation{"": ops}
+	if model.Partition != nil {
+		parts = make(map[string][]Operation)
+		for _, op := range ops {
+			key := model.Partition(op)
+			parts[key] = append(parts[key], op)
+		}
+	}
+
+	keys := make([]string, 0, len(parts))
+	for key := range parts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		switch linearizable(model, parts[key]) {
+		case nonLinear:
+			return &Result{
+				Partition: key,
+				Window:    minimalWindow(model, parts[key]),
+			}
+		case undecided:
+			return &Result{Unknown: true, Partition: key}
+		}
+	}
+	return &Result{Valid: true}
+}
+
+// minimalWindow shrinks a history that is not linearizable. It first finds
+// the earliest completion at which the history stops being linearizable,
+// then drops every operation whose removal keeps it that way. Searches that
+// give up count as linearizable here, so the window is always one that was
+// shown not to be.
+func minimalWindow(model Model, ops []Operation) []Operation {
+	var returns []time.Duration
+	for _, op := range ops {
+		if op.Known {
+			returns = append(returns, op.Return)
+		}
+	}
+	sort.Slice(returns, func(i, j int) bool { return returns[i] < returns[j] })
+
+	k := sort.Search(len(returns), func(i int) bool {
+		return linearizable(model, truncate(ops, returns[i])) == nonLinear
+	})
+	if k == len(returns) {
+		return ops
+	}
+	at := returns[k]
+	window := truncate(ops, at)
+
+	sort.Slice(window, func(i, j int) bool { return window[i].Call > window[j].Call })
+	for i := 0; i < len(window); {
+		if window[i].Known && window[i].Return == at {
+			i++
+			continue
+		}
+		without := append(append([]Operation(nil), window[:i]...), window[i+1:]...)
+		if linearizable(model, without) != nonLinear {
+			i++
+			continue
+		}
+		window = without
+	}
+
+	sort.Slice(window, func(i, j int) bool { return window[i].Call < window[j].Call })
+	return window
+}
+
+// truncate returns the history as it looked at time t: operations called
+// after t are left out, and those that had not returned by t have an unknown
+// outcome.
+func truncate(ops []Operation, t time.Duration) []Operation {
+	var out []Operation
+	for _, op := range ops {
+		if op.Call > t {
+			continue
+		}
+		if op.Return > t {
+			op.Output, op.Known, op.Return = nil, false, never
+		}
+		out = append(out, op)
+	}
+	return out
+}
+
+// canonical renders a value as compact JSON, so that values compare equal
+// regardless of their Go type.
+func canonical(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
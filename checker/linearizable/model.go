@@ -0,0 +1,55 @@
+package linearizable
+
+// Register models a single register holding a JSON value, initially null.
+// read operations have the value read as output, write operations the value
+// written as input, and cas operations a [from, to] pair as input.
+var Register = Model{
+	Name: "register",
+	Init: func() interface{} { return "null" },
+	Step: stepRegister,
+}
+
+// KV models a key-value store as independent registers, one per key, which
+// are checked separately. As in Jepsen's independent histories, inputs and
+// outputs are [key, value] pairs whose values follow Register.
+var KV = Model{
+	Name: "kv",
+	Init: func() interface{} { return "null" },
+	Step: func(state interface{}, op Operation) (bool, interface{}) {
+		_, op.Input = pair(op.Input)
+		_, op.Output = pair(op.Output)
+		return stepRegister(state, op)
+	},
+	Partition: func(op Operation) string {
+		key, _ := pair(op.Input)
+		return canonical(key)
+	},
+}
+
+func stepRegister(state interface{}, op Operation) (bool, interface{}) {
+	cur := state.(string)
+	switch op.F {
+	case "read":
+		return !op.Known || canonical(op.Output) == cur, cur
+	case "write":
+		return true, canonical(op.Input)
+	case "cas":
+		from, to := pair(op.Input)
+		if canonical(from) != cur {
+			// A cas with an unknown outcome may simply have failed.
+			return !op.Known, cur
+		}
+		return true, canonical(to)
+	default:
+		return false, cur
+	}
+}
+
+// pair splits a two-element list.
+func pair(v interface{}) (interface{}, interface{}) {
+	l, ok := v.([]interface{})
+	if !ok || len(l) != 2 {
+		return nil, nil
+	}
+	return l[0], l[1]
+}
@@ -0,0 +1,190 @@
+package linearizable
+
+import (
+	"hash/fnv"
+	"sort"
+	"time"
+)
+
+// entry is a call or return event in the doubly linked history the search
+// walks. Linearizing a call lifts it and its return out of the list.
+type entry struct {
+	op         int
+	call       bool
+	time       time.Duration
+	match      *entry
+	prev, next *entry
+}
+
+// events builds the history list for ops behind a sentinel head. Calls sort
+// before returns at the same instant, so touching operations count as
+// concurrent.
+func events(ops []Operation) *entry {
+	var all []*entry
+	for i, op := range ops {
+		ret := &entry{op: i, time: op.Return}
+		all = append(all, &entry{op: i, call: true, time: op.Call, match: ret}, ret)
+	}
+	sort.SliceStable(all, func(i, j int) bool {
+		if all[i].time != all[j].time {
+			return all[i].time < all[j].time
+		}
+		return all[i].call && !all[j].call
+	})
+
+	head := &entry{}
+	prev := head
+	for _, e := range all {
+		e.prev, prev.next = prev, e
+		prev = e
+	}
+	return head
+}
+
+func lift(e *entry) {
+	e.prev.next = e.next
+	if e.next != nil {
+		e.next.prev = e.prev
+	}
+	m := e.match
+	m.prev.next = m.next
+	if m.next != nil {
+		m.next.prev = m.prev
+	}
+}
+
+func unlift(e *entry) {
+	m := e.match
+	m.prev.next = m
+	if m.next != nil {
+		m.next.prev = m
+	}
+	e.prev.next = e
+	if e.next != nil {
+		e.next.prev = e
+	}
+}
+
+// bitset records which operations have been linearized.
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (b bitset) set(i int)   { b[i/64] |= 1 << (i % 64) }
+func (b bitset) clear(i int) { b[i/64] &^= 1 << (i % 64) }
+
+func (b bitset) clone() bitset {
+	return append(bitset(nil), b...)
+}
+
+func (b bitset) equals(o bitset) bool {
+	for i := range b {
+		if b[i] != o[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (b bitset) hash() uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	for _, w := range b {
+		for i := range buf {
+			buf[i] = byte(w >> (8 * i))
+		}
+		h.Write(buf[:])
+	}
+	return h.Sum64()
+}
+
+// maxConfigurations bounds how many distinct pairs of linearized set and
+// model state one search may explore before giving up. Operations with
+// unknown outcomes can make the search exponential.
+const maxConfigurations = 1 << 18
+
+// verdict is the outcome of one search.
+type verdict int
+
+const (
+	linear verdict = iota
+	nonLinear
+	undecided
+)
+
+type cacheEntry struct {
+	linearized bitset
+	state      interface{}
+}
+
+// linearizable searches for an order of ops that respects real time and
+// model. It repeatedly tries to linearize the earliest pending call; when it
+// reaches a return whose call is still pending, it backtracks. Pairs of
+// linearized set and model state already explored are not searched again.
+func linearizable(model Model, ops []Operation) verdict {
+	if len(ops) == 0 {
+		return linear
+	}
+
+	type frame struct {
+		e     *entry
+		state interface{}
+	}
+
+	head := events(ops)
+	linearized := newBitset(len(ops))
+	cache := make(map[uint64][]cacheEntry)
+	var calls []frame
+	configurations := 0
+
+	seen := func(lin bitset, state interface{}) bool {
+		h := lin.hash()
+		for _, c := range cache[h] {
+			if c.state == state && c.linearized.equals(lin) {
+				return true
+			}
+		}
+		cache[h] = append(cache[h], cacheEntry{linearized: lin, state: state})
+		configurations++
+		return false
+	}
+
+	state := model.Init()
+	e := head.next
+	for head.next != nil {
+		if !e.call {
+			if len(calls) == 0 {
+				return nonLinear
+			}
+			top := calls[len(calls)-1]
+			calls = calls[:len(calls)-1]
+
+			e, state = top.e, top.state
+			linearized.clear(e.op)
+			unlift(e)
+			e = e.next
+			continue
+		}
+
+		ok, next := model.Step(state, ops[e.op])
+		if ok {
+			lin := linearized.clone()
+			lin.set(e.op)
+			if !seen(lin, next) {
+				calls = append(calls, frame{e: e, state: state})
+				state = next
+				linearized.set(e.op)
+				lift(e)
+				if configurations > maxConfigurations {
+					return undecided
+				}
+				e = head.next
+				continue
+			}
+		}
+		e = e.next
+	}
+	return linear
+}
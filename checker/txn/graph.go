@@ -0,0 +1,95 @@
+package txn
+
+import "sort"
+
+// edgeKind is a set of dependency types between two transactions.
+type edgeKind uint8
+
+const (
+	// ww means the target overwrote or appended after the source's write.
+	ww edgeKind = 1 << iota
+	// wr means the target read the source's write.
+	wr
+)
+
+// graph is a dependency graph over transaction indices.
+type graph struct {
+	edges []map[int]edgeKind
+}
+
+func newGraph(n int) *graph {
+	edges := make([]map[int]edgeKind, n)
+	for i := range edges {
+		edges[i] = make(map[int]edgeKind)
+	}
+	return &graph{edges: edges}
+}
+
+func (g *graph) addEdge(from, to int, kind edgeKind) {
+	g.edges[from][to] |= kind
+}
+
+// cycles returns the strongly connected components with more than one
+// transaction, using only edges of the given kinds. Each component is sorted
+// and the components are ordered by their first transaction.
+func (g *graph) cycles(kinds edgeKind) [][]int {
+	n := len(g.edges)
+	index := make([]int, n)
+	low := make([]int, n)
+	onStack := make([]bool, n)
+	for i := range index {
+		index[i] = -1
+	}
+
+	var stack []int
+	var sccs [][]int
+	next := 0
+
+	// Tarjan's algorithm.
+	var visit func(v int)
+	visit = func(v int) {
+		index[v], low[v] = next, next
+		next++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for w, kind := range g.edges[v] {
+			if kind&kinds == 0 {
+				continue
+			}
+			if index[w] < 0 {
+				visit(w)
+				low[v] = min(low[v], low[w])
+			} else if onStack[w] {
+				low[v] = min(low[v], index[w])
+			}
+		}
+
+		if low[v] != index[v] {
+			return
+		}
+		var scc []int
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			scc = append(scc, w)
+			if w == v {
+				break
+			}
+		}
+		if len(scc) > 1 {
+			sort.Ints(scc)
+			sccs = append(sccs, scc)
+		}
+	}
+
+	for v := 0; v < n; v++ {
+		if index[v] < 0 {
+			visit(v)
+		}
+	}
+
+	sort.Slice(sccs, func(i, j int) bool { return sccs[i][0] < sccs[j][0] })
+	return sccs
+}
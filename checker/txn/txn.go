@@ -0,0 +1,303 @@
+// Package txn checks the results of transactional workloads for the
+// anomalies described by Adya: write cycles (G0), aborted reads (G1a),
+// intermediate reads (G1b) and circular information flow (G1c), plus lost
+// updates. It handles both the rw-register and the list-append workloads.
+package txn
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Type is the outcome of a transaction from the client's point of view.
+type Type string
+
+const (
+	// OK transactions were acknowledged by the node.
+	OK Type = "ok"
+	// Fail transactions were definitely rejected and must not be visible.
+	Fail Type = "fail"
+	// Info transactions have an unknown outcome, such as a timeout.
+	Info Type = "info"
+)
+
+// MicroOp is one [f, key, value] operation of a transaction. F is "r", "w" or
+// "append". Value is nil for unread registers, an int for writes, appends and
+// register reads, and a []int for list reads.
+type MicroOp struct {
+	F     string
+	Key   int
+	Value interface{}
+}
+
+// Txn is one transaction as the client observed it. For OK transactions Ops
+// holds the node's reply, with read values filled in; otherwise it holds the
+// request.
+type Txn struct {
+	Process int
+	Type    Type
+	Ops     []MicroOp
+}
+
+// Result lists the anomalies found, keyed by anomaly name.
+type Result struct {
+	Valid     bool
+	Anomalies map[string][]string
+}
+
+func (r *Result) add(anomaly string, format string, args ...interface{}) {
+	r.Valid = false
+	r.Anomalies[anomaly] = append(r.Anomalies[anomaly], fmt.Sprintf(format, args...))
+}
+
+func newResult() *Result {
+	return &Result{
+		Valid:     true,
+		Anomalies: make(map[string][]string),
+	}
+}
+
+// write identifies the transaction that wrote or appended a value to a key,
+// and whether it was that transaction's last write to the key.
+type write struct {
+	txn   int
+	final bool
+}
+
+type keyValue struct {
+	key   int
+	value int
+}
+
+// indexWrites maps every written value to its writer. Workloads write each
+// value at most once per key, so the mapping is unambiguous.
+func indexWrites(txns []Txn, f string) map[keyValue]write {
+	writes := make(map[keyValue]write)
+	for i, t := range txns {
+		last := make(map[int]int)
+		for j, op := range t.Ops {
+			if op.F == f {
+				last[op.Key] = j
+			}
+		}
+		for j, op := range t.Ops {
+			v, ok := op.Value.(int)
+			if op.F != f || !ok {
+				continue
+			}
+			writes[keyValue{op.Key, v}] = write{txn: i, final: last[op.Key] == j}
+		}
+	}
+	return writes
+}
+
+// checkRead reports aborted and intermediate reads of value v of key by
+// transaction reader.
+func checkRead(r *Result, txns []Txn, writes map[keyValue]write, reader int, key int, v int, intermediate bool) {
+	w, ok := writes[keyValue{key, v}]
+	if !ok || w.txn == reader {
+		return
+	}
+	if txns[w.txn].Type == Fail {
+		r.add("G1a", "txn %d read %d from key %d, written by failed txn %d", reader, v, key, w.txn)
+	}
+	if intermediate && !w.final {
+		r.add("G1b", "txn %d read %d from key %d, an intermediate write of txn %d", reader, v, key, w.txn)
+	}
+}
+
+// externalRead is the first read of a key in a transaction, made before the
+// transaction wrote that key itself.
+type externalRead struct {
+	txn   int
+	key   int
+	value interface{}
+}
+
+// externalReads returns the reads that observed other transactions' state,
+// and the keys the transaction wrote.
+func externalReads(t Txn, txn int, f string) (reads []externalRead, wrote map[int]bool) {
+	wrote = make(map[int]bool)
+	read := make(map[int]bool)
+	for _, op := range t.Ops {
+		switch op.F {
+		case "r":
+			if !wrote[op.Key] && !read[op.Key] {
+				reads = append(reads, externalRead{txn: txn, key: op.Key, value: op.Value})
+				read[op.Key] = true
+			}
+		case f:
+			wrote[op.Key] = true
+		}
+	}
+	return reads, wrote
+}
+
+// checkLostUpdates reports committed transactions that read the same version
+// of a key and then both wrote it. version renders a read value as a
+// comparable version identifier.
+func checkLostUpdates(r *Result, txns []Txn, f string, version func(interface{}) string) {
+	type keyVersion struct {
+		key     int
+		version string
+	}
+	writers := make(map[keyVersion][]int)
+	for i, t := range txns {
+		if t.Type != OK {
+			continue
+		}
+		reads, wrote := externalReads(t, i, f)
+		for _, rd := range reads {
+			if wrote[rd.key] {
+				kv := keyVersion{rd.key, version(rd.value)}
+				writers[kv] = append(writers[kv], i)
+			}
+		}
+	}
+
+	var lost []keyVersion
+	for kv, ts := range writers {
+		if len(ts) > 1 {
+			lost = append(lost, kv)
+		}
+	}
+	sort.Slice(lost, func(i, j int) bool { return writers[lost[i]][0] < writers[lost[j]][0] })
+
+	for _, kv := range lost {
+		r.add("lost-update", "txns %v all read key %d at %s and then wrote it", writers[kv], kv.key, kv.version)
+	}
+}
+
+// CheckListAppend checks a list-append history. The version order of each
+// key is taken from its longest read, which every other read must be a prefix
+// of.
+func CheckListAppend(txns []Txn) *Result {
+	r := newResult()
+	writes := indexWrites(txns, "append")
+	g := newGraph(len(txns))
+
+	longest := make(map[int][]int)
+	for i, t := range txns {
+		if t.Type != OK {
+			continue
+		}
+		for _, op := range t.Ops {
+			list, ok := op.Value.([]int)
+			if op.F != "r" || !ok {
+				continue
+			}
+
+			for j, v := range list {
+				checkRead(r, txns, writes, i, op.Key, v, j == len(list)-1)
+			}
+			if len(list) > 0 {
+				if w, ok := writes[keyValue{op.Key, list[len(list)-1]}]; ok && w.txn != i && txns[w.txn].Type != Fail {
+					g.addEdge(w.txn, i, wr)
+				}
+			}
+
+			prev := longest[op.Key]
+			if len(list) > len(prev) {
+				prev, list = list, prev
+				longest[op.Key] = prev
+			}
+			for j, v := range list {
+				if prev[j] != v {
+					r.add("incompatible-order", "key %d was read as both %v and %v", op.Key, list, prev)
+					break
+				}
+			}
+		}
+	}
+
+	for key, order := range longest {
+		for j := 1; j < len(order); j++ {
+			a, aok := writes[keyValue{key, order[j-1]}]
+			b, bok := writes[keyValue{key, order[j]}]
+			if aok && bok && a.txn != b.txn && txns[a.txn].Type != Fail && txns[b.txn].Type != Fail {
+				g.addEdge(a.txn, b.txn, ww)
+			}
+		}
+	}
+
+	checkLostUpdates(r, txns, "append", func(v interface{}) string {
+		list, _ := v.([]int)
+		return fmt.Sprint(list)
+	})
+	checkCycles(r, g)
+
+	return r
+}
+
+// CheckRWRegister checks an rw-register history. Without list reads the
+// version order is only known where a transaction read a key and then
+// overwrote it, so G0 and G1c detection is limited to those write
+// dependencies.
+func CheckRWRegister(txns []Txn) *Result {
+	r := newResult()
+	writes := indexWrites(txns, "w")
+	g := newGraph(len(txns))
+
+	for i, t := range txns {
+		if t.Type != OK {
+			continue
+		}
+
+		for _, op := range t.Ops {
+			if v, ok := op.Value.(int); ok && op.F == "r" {
+				checkRead(r, txns, writes, i, op.Key, v, true)
+			}
+		}
+
+		reads, wrote := externalReads(t, i, "w")
+		for _, rd := range reads {
+			v, ok := rd.value.(int)
+			if !ok {
+				continue
+			}
+			w, ok := writes[keyValue{rd.key, v}]
+			if !ok || txns[w.txn].Type == Fail {
+				continue
+			}
+			g.addEdge(w.txn, i, wr)
+			if wrote[rd.key] {
+				g.addEdge(w.txn, i, ww)
+			}
+		}
+	}
+
+	checkLostUpdates(r, txns, "w", func(v interface{}) string {
+		if v == nil {
+			return "nil"
+		}
+		return fmt.Sprint(v)
+	})
+	checkCycles(r, g)
+
+	return r
+}
+
+// checkCycles reports cycles of write dependencies alone as G0, and any other
+// cycle of write and read dependencies as G1c.
+func checkCycles(r *Result, g *graph) {
+	g0 := make(map[string]bool)
+	for _, scc := range g.cycles(ww) {
+		g0[fmt.Sprint(scc)] = true
+		r.add("G0", "txns %v form a cycle of write dependencies", scc)
+	}
+	for _, scc := range g.cycles(ww | wr) {
+		if !g0[fmt.Sprint(scc)] {
+			r.add("G1c", "txns %v form a cycle of write and read dependencies", scc)
+		}
+	}
+}
+
+// Names returns the names of the anomalies in r in a stable order.
+func (r *Result) Names() []string {
+	names := make([]string, 0, len(r.Anomalies))
+	for name := range r.Anomalies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	nemesispb "github.com/Shresth72/go_gRPC_tester/proto/nemesis"
+)
+
+// nodeClock is the skew of one node's clock: at reference it read the real
+// time plus offset, and it gains drift microseconds per second after that.
+// A node computes its time t as
+//
+//	t = now + offset + (now - reference) * drift / 1e6
+type nodeClock struct {
+	offset    time.Duration
+	drift     float64
+	reference time.Time
+}
+
+// env returns the environment variables that tell a starting node its clock.
+func (c nodeClock) env() []string {
+	return []string{
+		"NODE_CLOCK_OFFSET_MS=" + strconv.FormatInt(c.offset.Milliseconds(), 10),
+		"NODE_CLOCK_DRIFT_PPM=" + strconv.FormatFloat(c.drift, 'g', -1, 64),
+		"NODE_CLOCK_REFERENCE_MS=" + strconv.FormatInt(c.reference.UnixMilli(), 10),
+	}
+}
+
+// message returns the "clock" message that tells a running node its clock.
+func (c nodeClock) message(src, dest string) *message {
+	body := map[string]interface{}{
+		"type":         "clock",
+		"offset_ms":    c.offset.Milliseconds(),
+		"drift_ppm":    c.drift,
+		"reference_ms": c.reference.UnixMilli(),
+	}
+	fields := make(map[string]json.RawMessage, len(body))
+	for k, v := range body {
+		fields[k], _ = json.Marshal(v)
+	}
+	return &message{Src: src, Dest: dest, Body: fields}
+}
+
+// clocks holds the clock of every node that has been skewed.
+type clocks struct {
+	mu     sync.Mutex
+	byNode map[string]nodeClock
+}
+
+func newClocks() *clocks {
+	return &clocks{byNode: make(map[string]nodeClock)}
+}
+
+func (c *clocks) set(id string, clock nodeClock) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.byNode[id] = clock
+}
+
+func (c *clocks) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.byNode = make(map[string]nodeClock)
+}
+
+// env returns the clock environment of node id, which is empty for a node
+// whose clock has not been skewed.
+func (c *clocks) env(id string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clock, ok := c.byNode[id]
+	if !ok {
+		return nil
+	}
+	return clock.env()
+}
+
+// SetClock stores the new clocks and sends each running node a clock
+// message. Clocks are kept even if a node does not acknowledge the message.
+func (s *server) SetClock(ctx context.Context, in *nemesispb.SetClockRequest) (*nemesispb.SetClockResponse, error) {
+	now := time.Now()
+	for _, nc := range in.Clocks {
+		if nc.NodeId == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "clock has no node_id")
+		}
+	}
+
+	s.mu.RLock()
+	src := s.initSrc
+	s.mu.RUnlock()
+
+	errs := make([]error, len(in.Clocks))
+	s.forEach(len(in.Clocks), func(i int) {
+		nc := in.Clocks[i]
+		clock := nodeClock{
+			offset:    time.Duration(nc.OffsetMs) * time.Millisecond,
+			drift:     nc.DriftPpm,
+			reference: now,
+		}
+		s.clocks.set(nc.NodeId, clock)
+
+		if n := s.node(nc.NodeId); n != nil && n.running() {
+			errs[i] = s.sendClock(ctx, clock.message(src, n.id))
+		}
+	})
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &nemesispb.SetClockResponse{}, nil
+}
+
+// sendClock sends a clock message and waits for the node's clock_ok.
+func (s *server) sendClock(ctx context.Context, msg *message) error {
+	reply, err := s.roundTrip(ctx, msg)
+	if err != nil {
+		return err
+	}
+	if err := reply.errorStatus(); err != nil {
+		return status.Errorf(status.Code(err), "node %s rejected its clock: %v", msg.Dest, err)
+	}
+	if reply.bodyType() != "clock_ok" {
+		return status.Errorf(codes.Internal, "expected clock_ok reply from %s, got %s", msg.Dest, reply.bodyType())
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// config holds the server's settings. Values come from an optional JSON file
+// given with -config; flags set on the command line take precedence.
+type config struct {
+	Addr   string `json:"addr"`
+	BinDir string `json:"bin_dir"`
+	// SimQuietMs is how long a seeded cluster's event loop waits for the
+	// nodes to go quiet before each delivery. Nodes that take longer to react
+	// to a message make the run diverge from its replay.
+	SimQuietMs int `json:"sim_quiet_ms"`
+}
+
+func loadConfig() (*config, error) {
+	cfg := &config{
+		Addr:       ":5051",
+		BinDir:     "target/debug",
+		SimQuietMs: 2,
+	}
+
+	configPath := flag.String("config", "", "path to a JSON config file")
+	addr := flag.String("addr", cfg.Addr, "address to listen on")
+	binDir := flag.String("bin-dir", cfg.BinDir, "directory that node binaries must live in")
+	simQuiet := flag.Int("sim-quiet-ms", cfg.SimQuietMs, "milliseconds a seeded cluster waits for the nodes to go quiet before each delivery")
+	flag.Parse()
+
+	if *configPath != "" {
+		data, err := os.ReadFile(*configPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config: %w", err)
+		}
+		if err := json.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("failed to parse config %s: %w", *configPath, err)
+		}
+	}
+
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "addr":
+			cfg.Addr = *addr
+		case "bin-dir":
+			cfg.BinDir = *binDir
+		case "sim-quiet-ms":
+			cfg.SimQuietMs = *simQuiet
+		}
+	})
+
+	if cfg.SimQuietMs <= 0 {
+		return nil, fmt.Errorf("sim_quiet_ms must be positive: %d", cfg.SimQuietMs)
+	}
+
+	binDirAbs, err := filepath.Abs(cfg.BinDir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bin_dir %q: %w", cfg.BinDir, err)
+	}
+	cfg.BinDir = binDirAbs
+
+	return cfg, nil
+}
+
+// resolveBinary turns a path from a LaunchRequest into an absolute path and
+// checks that it points at a file inside the binaries directory.
+func (c *config) resolveBinary(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("binary path is empty")
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(c.BinDir, path)
+	}
+	path = filepath.Clean(path)
+
+	rel, err := filepath.Rel(c.BinDir, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is outside the binaries directory %s", path, c.BinDir)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("%s is a directory", path)
+	}
+
+	return path, nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+
+	counterpb "github.com/Shresth72/go_gRPC_tester/proto/counter"
+	gsetpb "github.com/Shresth72/go_gRPC_tester/proto/gset"
+)
+
+func (s *server) SendGSetAdd(ctx context.Context, in *gsetpb.GSetAddRequest) (*gsetpb.GSetAddResponse, error) {
+	out := &gsetpb.GSetAddResponse{}
+	if err := s.call(ctx, in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *server) SendGSetRead(ctx context.Context, in *gsetpb.GSetReadRequest) (*gsetpb.GSetReadResponse, error) {
+	out := &gsetpb.GSetReadResponse{}
+	if err := s.call(ctx, in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *server) SendCounterAdd(ctx context.Context, in *counterpb.CounterAddRequest) (*counterpb.CounterAddResponse, error) {
+	out := &counterpb.CounterAddResponse{}
+	if err := s.call(ctx, in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *server) SendCounterRead(ctx context.Context, in *counterpb.CounterReadRequest) (*counterpb.CounterReadResponse, error) {
+	out := &counterpb.CounterReadResponse{}
+	if err := s.call(ctx, in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
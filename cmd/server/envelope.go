@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	maelstrompb "github.com/Shresth72/go_gRPC_tester/proto/maelstrom"
+)
+
+// envelopeMessage converts a generic request into a message, remembering
+// whether the body was given as a Struct so the reply can use the same form.
+func envelopeMessage(in *maelstrompb.MessageRequest) (*message, bool, error) {
+	var raw []byte
+	asStruct := false
+
+	switch p := in.Payload.(type) {
+	case *maelstrompb.MessageRequest_Body:
+		b, err := protojson.Marshal(p.Body)
+		if err != nil {
+			return nil, false, status.Errorf(codes.InvalidArgument, "invalid body: %v", err)
+		}
+		raw, asStruct = b, true
+	case *maelstrompb.MessageRequest_RawBody:
+		raw = []byte(p.RawBody)
+	default:
+		return nil, false, status.Errorf(codes.InvalidArgument, "message has no body")
+	}
+
+	msg := &message{Src: in.Src, Dest: in.Dest}
+	if err := json.Unmarshal(raw, &msg.Body); err != nil || msg.Body == nil {
+		return nil, false, status.Errorf(codes.InvalidArgument, "body must be a JSON object")
+	}
+
+	return msg, asStruct, nil
+}
+
+func envelopeResponse(reply *message, asStruct bool) (*maelstrompb.MessageResponse, error) {
+	raw, err := json.Marshal(reply.Body)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal reply: %v", err)
+	}
+
+	out := &maelstrompb.MessageResponse{
+		Src:  reply.Src,
+		Dest: reply.Dest,
+	}
+	if !asStruct {
+		out.Payload = &maelstrompb.MessageResponse_RawBody{RawBody: string(raw)}
+		return out, nil
+	}
+
+	body := &structpb.Struct{}
+	if err := protojson.Unmarshal(raw, body); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert reply: %v", err)
+	}
+	out.Payload = &maelstrompb.MessageResponse_Body{Body: body}
+
+	return out, nil
+}
+
+// errorReply turns a failed round trip into the Maelstrom error body a node
+// would have sent, so that streams report failures in-band.
+func errorReply(req *message, clientMsgID json.RawMessage, err error) *message {
+	st := status.Convert(err)
+
+	code := 13 // crash
+	switch st.Code() {
+	case codes.DeadlineExceeded, codes.Canceled:
+		code = 0 // timeout
+	case codes.NotFound:
+		code = 1 // node-not-found
+	case codes.InvalidArgument:
+		code = 12 // malformed-request
+	}
+
+	reply := &message{
+		Src:  req.Dest,
+		Dest: req.Src,
+		Body: map[string]json.RawMessage{},
+	}
+	reply.Body["type"] = json.RawMessage(`"error"`)
+	reply.setBodyInt("code", int64(code))
+	text, _ := json.Marshal(st.Message())
+	reply.Body["text"] = text
+	if clientMsgID != nil {
+		reply.Body["in_reply_to"] = clientMsgID
+	}
+
+	return reply
+}
+
+func (s *server) SendMessage(ctx context.Context, in *maelstrompb.MessageRequest) (*maelstrompb.MessageResponse, error) {
+	msg, asStruct, err := envelopeMessage(in)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := s.roundTrip(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return envelopeResponse(reply, asStruct)
+}
+
+// Stream sends each incoming message as soon as it arrives and streams the
+// replies back in the order the nodes produce them. Callers correlate replies
+// through body.in_reply_to. A message that cannot be read is answered with a
+// malformed-request error, which has no in_reply_to, and the stream carries on.
+func (s *server) Stream(stream maelstrompb.MessageService_StreamServer) error {
+	ctx := stream.Context()
+
+	var sendMu sync.Mutex
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		msg, asStruct, err := envelopeMessage(in)
+		if err != nil {
+			reply := errorReply(&message{Src: in.Src, Dest: in.Dest}, nil, err)
+			out, err := envelopeResponse(reply, in.GetBody() != nil)
+			if err != nil {
+				return err
+			}
+
+			sendMu.Lock()
+			err = stream.Send(out)
+			sendMu.Unlock()
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			clientMsgID := msg.Body["msg_id"]
+			reply, err := s.roundTrip(ctx, msg)
+			if err != nil {
+				reply = errorReply(msg, clientMsgID, err)
+			}
+
+			out, err := envelopeResponse(reply, asStruct)
+			if err != nil {
+				return
+			}
+
+			sendMu.Lock()
+			defer sendMu.Unlock()
+			stream.Send(out)
+		}()
+	}
+}
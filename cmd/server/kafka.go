@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+
+	kafkapb "github.com/Shresth72/go_gRPC_tester/proto/kafka"
+)
+
+func (s *server) Send(ctx context.Context, in *kafkapb.SendRequest) (*kafkapb.SendResponse, error) {
+	out := &kafkapb.SendResponse{}
+	if err := s.call(ctx, in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *server) Poll(ctx context.Context, in *kafkapb.PollRequest) (*kafkapb.PollResponse, error) {
+	out := &kafkapb.PollResponse{}
+	if err := s.call(ctx, in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *server) CommitOffsets(ctx context.Context, in *kafkapb.CommitOffsetsRequest) (*kafkapb.CommitOffsetsResponse, error) {
+	out := &kafkapb.CommitOffsetsResponse{}
+	if err := s.call(ctx, in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *server) ListCommittedOffsets(ctx context.Context, in *kafkapb.ListCommittedOffsetsRequest) (*kafkapb.ListCommittedOffsetsResponse, error) {
+	out := &kafkapb.ListCommittedOffsetsResponse{}
+	if err := s.call(ctx, in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
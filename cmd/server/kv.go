@@ -0,0 +1,429 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Maelstrom error codes returned by the key-value services.
+const (
+	errKeyDoesNotExist    = 20
+	errPreconditionFailed = 22
+	errNotSupported       = 10
+	errMalformedRequest   = 12
+)
+
+// kvStore is the storage behind one of Maelstrom's built-in key-value
+// services. client is the node making the request, which the weaker
+// consistency models use to decide what it may observe. Keys and values are
+// compact JSON.
+type kvStore interface {
+	read(client, key string) (json.RawMessage, bool)
+	write(client, key string, value json.RawMessage)
+	// cas returns 0 on success or the Maelstrom error code for the failure.
+	cas(client, key string, from, to json.RawMessage, create bool) int
+}
+
+// kvService answers messages addressed to a key-value service by name.
+type kvService struct {
+	name      string
+	store     kvStore
+	nextMsgID atomic.Int64
+}
+
+// newKVServices returns empty key-value services, whose weaker consistency
+// models draw from seed. Each store has a generator of its own, guarded by
+// the store's lock. With background set, lww-kv gossips on a ticker until
+// the services are stopped with stopKVServices; otherwise the caller drives
+// the gossip with gossipKVServices.
+func newKVServices(seed int64, background bool) map[string]*kvService {
+	rng := rand.New(rand.NewSource(seed))
+
+	services := make(map[string]*kvService)
+	for _, svc := range []*kvService{
+		{name: "lin-kv", store: newLinKV()},
+		{name: "seq-kv", store: newSeqKV(rand.New(rand.NewSource(rng.Int63())))},
+		{name: "lww-kv", store: newLWWKV(rand.New(rand.NewSource(rng.Int63())), lwwReplicas, background)},
+	} {
+		services[svc.name] = svc
+	}
+	return services
+}
+
+// stopKVServices stops the background work of services, such as lww-kv's
+// gossip.
+func stopKVServices(services map[string]*kvService) {
+	for _, svc := range services {
+		if s, ok := svc.store.(interface{ stop() }); ok {
+			s.stop()
+		}
+	}
+}
+
+// gossipKVServices runs one round of gossip in the services that have it,
+// in a fixed order.
+func gossipKVServices(services map[string]*kvService) {
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if s, ok := services[name].store.(interface{ gossip() }); ok {
+			s.gossip()
+		}
+	}
+}
+
+// handle applies one read, write or cas request and builds the reply.
+func (svc *kvService) handle(msg *message) *message {
+	var req struct {
+		Type   string          `json:"type"`
+		Key    json.RawMessage `json:"key"`
+		Value  json.RawMessage `json:"value"`
+		From   json.RawMessage `json:"from"`
+		To     json.RawMessage `json:"to"`
+		Create bool            `json:"create_if_not_exists"`
+	}
+	raw, _ := json.Marshal(msg.Body)
+
+	reply := &message{
+		Src:  svc.name,
+		Dest: msg.Src,
+		Body: map[string]json.RawMessage{},
+	}
+	reply.setBodyInt("msg_id", svc.nextMsgID.Add(1))
+	if id, ok := msg.bodyInt("msg_id"); ok {
+		reply.setBodyInt("in_reply_to", id)
+	}
+
+	fail := func(code int, format string, args ...interface{}) *message {
+		reply.Body["type"] = json.RawMessage(`"error"`)
+		reply.setBodyInt("code", int64(code))
+		text, _ := json.Marshal(fmt.Sprintf(format, args...))
+		reply.Body["text"] = text
+		return reply
+	}
+
+	if err := json.Unmarshal(raw, &req); err != nil || req.Key == nil {
+		return fail(errMalformedRequest, "malformed %s request", svc.name)
+	}
+	key := compactJSON(req.Key)
+
+	switch req.Type {
+	case "read":
+		value, ok := svc.store.read(msg.Src, key)
+		if !ok {
+			return fail(errKeyDoesNotExist, "key %s does not exist", key)
+		}
+		reply.Body["type"] = json.RawMessage(`"read_ok"`)
+		reply.Body["value"] = value
+	case "write":
+		if req.Value == nil {
+			return fail(errMalformedRequest, "write to %s has no value", key)
+		}
+		svc.store.write(msg.Src, key, json.RawMessage(compactJSON(req.Value)))
+		reply.Body["type"] = json.RawMessage(`"write_ok"`)
+	case "cas":
+		if req.From == nil || req.To == nil {
+			return fail(errMalformedRequest, "cas on %s needs from and to", key)
+		}
+		from := json.RawMessage(compactJSON(req.From))
+		to := json.RawMessage(compactJSON(req.To))
+		switch code := svc.store.cas(msg.Src, key, from, to, req.Create); code {
+		case 0:
+			reply.Body["type"] = json.RawMessage(`"cas_ok"`)
+		case errKeyDoesNotExist:
+			return fail(code, "key %s does not exist", key)
+		default:
+			return fail(code, "expected %s at key %s", from, key)
+		}
+	default:
+		return fail(errNotSupported, "%s does not support %q", svc.name, req.Type)
+	}
+
+	return reply
+}
+
+func compactJSON(raw json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return string(raw)
+	}
+	return buf.String()
+}
+
+// serveKV answers a node's request to a key-value service. It reports
+// whether dest named a service at all.
+func (s *server) serveKV(msg *message) bool {
+	s.mu.RLock()
+	svc, ok := s.services[msg.Dest]
+	s.mu.RUnlock()
+	if !ok {
+		return false
+	}
+
+	reply := svc.handle(msg)
+	if s.node(reply.Dest) == nil {
+		log.Printf("dropping %s reply to unknown node %q", svc.name, reply.Dest)
+		return true
+	}
+	s.deliverAfter(reply, 0)
+	return true
+}
+
+// linKV is a linearizable store: a single map that every request sees.
+type linKV struct {
+	mu     sync.Mutex
+	values map[string]json.RawMessage
+}
+
+func newLinKV() *linKV {
+	return &linKV{values: make(map[string]json.RawMessage)}
+}
+
+func (kv *linKV) read(client, key string) (json.RawMessage, bool) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	v, ok := kv.values[key]
+	return v, ok
+}
+
+func (kv *linKV) write(client, key string, value json.RawMessage) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	kv.values[key] = value
+}
+
+func (kv *linKV) cas(client, key string, from, to json.RawMessage, create bool) int {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	return casValue(kv.values, key, from, to, create)
+}
+
+// casValue applies a compare-and-set to values.
+func casValue(values map[string]json.RawMessage, key string, from, to json.RawMessage, create bool) int {
+	cur, ok := values[key]
+	switch {
+	case !ok && !create:
+		return errKeyDoesNotExist
+	case ok && !bytes.Equal(cur, from):
+		return errPreconditionFailed
+	}
+	values[key] = to
+	return 0
+}
+
+// seqKV is a sequentially consistent store. Every update creates a new
+// version of the store, and each client may read any version no older than
+// the last one it observed, so reads can be stale but never go backwards for
+// the same client. Updates always apply to the latest version.
+type seqKV struct {
+	mu      sync.Mutex
+	rng     *rand.Rand
+	version int
+	history map[string][]seqVersion
+	floor   map[string]int
+}
+
+type seqVersion struct {
+	version int
+	value   json.RawMessage
+}
+
+func newSeqKV(rng *rand.Rand) *seqKV {
+	return &seqKV{
+		rng:     rng,
+		history: make(map[string][]seqVersion),
+		floor:   make(map[string]int),
+	}
+}
+
+// at returns the value of key as of version. Callers must hold kv.mu.
+func (kv *seqKV) at(key string, version int) (json.RawMessage, bool) {
+	versions := kv.history[key]
+	for i := len(versions) - 1; i >= 0; i-- {
+		if versions[i].version <= version {
+			return versions[i].value, true
+		}
+	}
+	return nil, false
+}
+
+func (kv *seqKV) read(client, key string) (json.RawMessage, bool) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	floor := kv.floor[client]
+	v := floor + kv.rng.Intn(kv.version-floor+1)
+	kv.floor[client] = v
+
+	return kv.at(key, v)
+}
+
+func (kv *seqKV) write(client, key string, value json.RawMessage) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	kv.update(client, key, value)
+}
+
+// update appends a new version with key set to value. Callers must hold
+// kv.mu.
+func (kv *seqKV) update(client, key string, value json.RawMessage) {
+	kv.version++
+	kv.history[key] = append(kv.history[key], seqVersion{version: kv.version, value: value})
+	kv.floor[client] = kv.version
+}
+
+func (kv *seqKV) cas(client, key string, from, to json.RawMessage, create bool) int {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	kv.floor[client] = kv.version
+
+	cur, ok := kv.at(key, kv.version)
+	switch {
+	case !ok && !create:
+		return errKeyDoesNotExist
+	case ok && !bytes.Equal(cur, from):
+		return errPreconditionFailed
+	}
+	kv.update(client, key, to)
+	return 0
+}
+
+// lwwReplicas is how many replicas the lww-kv service spreads writes over.
+const lwwReplicas = 3
+
+// lwwGossipInterval is how often two lww-kv replicas exchange state.
+const lwwGossipInterval = 100 * time.Millisecond
+
+// lwwKV is an eventually consistent store with last-write-wins conflict
+// resolution. Each request goes to a random replica, and replicas converge
+// by periodically merging their state, keeping the newest write per key.
+type lwwKV struct {
+	mu       sync.Mutex
+	rng      *rand.Rand
+	replicas []map[string]lwwEntry
+	clock    int64
+
+	done    chan struct{} // closed to stop gossiping
+	stopped chan struct{} // closed once gossiping has stopped
+}
+
+type lwwEntry struct {
+	ts    int64
+	value json.RawMessage
+}
+
+// newLWWKV returns an empty store that gossips every lwwGossipInterval if
+// background is set.
+func newLWWKV(rng *rand.Rand, replicas int, background bool) *lwwKV {
+	kv := &lwwKV{
+		rng:      rng,
+		replicas: make([]map[string]lwwEntry, replicas),
+	}
+	for i := range kv.replicas {
+		kv.replicas[i] = make(map[string]lwwEntry)
+	}
+
+	if background {
+		kv.done = make(chan struct{})
+		kv.stopped = make(chan struct{})
+		go kv.gossipEvery(lwwGossipInterval)
+	}
+
+	return kv
+}
+
+// gossipEvery gossips once per interval until the store is stopped.
+func (kv *lwwKV) gossipEvery(interval time.Duration) {
+	defer close(kv.stopped)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			kv.gossip()
+		case <-kv.done:
+			return
+		}
+	}
+}
+
+// stop ends the background gossip, if any, and waits for it.
+func (kv *lwwKV) stop() {
+	if kv.done == nil {
+		return
+	}
+	close(kv.done)
+	<-kv.stopped
+}
+
+// replica picks a random replica and a fresh timestamp. Callers must hold
+// kv.mu.
+func (kv *lwwKV) replica() (map[string]lwwEntry, int64) {
+	kv.clock++
+	return kv.replicas[kv.rng.Intn(len(kv.replicas))], kv.clock
+}
+
+func (kv *lwwKV) read(client, key string) (json.RawMessage, bool) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	r, _ := kv.replica()
+	e, ok := r[key]
+	return e.value, ok
+}
+
+func (kv *lwwKV) write(client, key string, value json.RawMessage) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	r, ts := kv.replica()
+	r[key] = lwwEntry{ts: ts, value: value}
+}
+
+func (kv *lwwKV) cas(client, key string, from, to json.RawMessage, create bool) int {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	r, ts := kv.replica()
+	e, ok := r[key]
+	switch {
+	case !ok && !create:
+		return errKeyDoesNotExist
+	case ok && !bytes.Equal(e.value, from):
+		return errPreconditionFailed
+	}
+	r[key] = lwwEntry{ts: ts, value: to}
+	return 0
+}
+
+// gossip merges one random replica into another.
+func (kv *lwwKV) gossip() {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+
+	from := kv.replicas[kv.rng.Intn(len(kv.replicas))]
+	to := kv.replicas[kv.rng.Intn(len(kv.replicas))]
+	for key, e := range from {
+		if cur, ok := to[key]; !ok || e.ts > cur.ts {
+			to[key] = e
+		}
+	}
+}
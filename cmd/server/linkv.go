@@ -0,0 +1,31 @@
+package main
+
+import (
+	"context"
+
+	kvpb "github.com/Shresth72/go_gRPC_tester/proto/kv"
+)
+
+func (s *server) SendKVRead(ctx context.Context, in *kvpb.KVReadRequest) (*kvpb.KVReadResponse, error) {
+	out := &kvpb.KVReadResponse{}
+	if err := s.call(ctx, in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *server) SendKVWrite(ctx context.Context, in *kvpb.KVWriteRequest) (*kvpb.KVWriteResponse, error) {
+	out := &kvpb.KVWriteResponse{}
+	if err := s.call(ctx, in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *server) SendKVCas(ctx context.Context, in *kvpb.KVCasRequest) (*kvpb.KVCasResponse, error) {
+	out := &kvpb.KVCasResponse{}
+	if err := s.call(ctx, in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
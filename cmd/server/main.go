@@ -1,24 +1,36 @@
 package main
 
-// go build -o bin/server cmd/server/main.go && ./bin/server
+// go build -o bin/server ./cmd/server && ./bin/server -bin-dir ../distributed_systems/target/debug
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"log"
 	"net"
-	"os"
-	"os/exec"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	broadcastpb "github.com/Shresth72/go_gRPC_tester/proto/broadcast"
+	counterpb "github.com/Shresth72/go_gRPC_tester/proto/counter"
 	echopb "github.com/Shresth72/go_gRPC_tester/proto/echo"
+	gsetpb "github.com/Shresth72/go_gRPC_tester/proto/gset"
 	initpb "github.com/Shresth72/go_gRPC_tester/proto/init"
+	kafkapb "github.com/Shresth72/go_gRPC_tester/proto/kafka"
+	kvpb "github.com/Shresth72/go_gRPC_tester/proto/kv"
+	maelstrompb "github.com/Shresth72/go_gRPC_tester/proto/maelstrom"
+	nemesispb "github.com/Shresth72/go_gRPC_tester/proto/nemesis"
+	processpb "github.com/Shresth72/go_gRPC_tester/proto/process"
+	statspb "github.com/Shresth72/go_gRPC_tester/proto/stats"
+	txnpb "github.com/Shresth72/go_gRPC_tester/proto/txn"
 	uniqueidpb "github.com/Shresth72/go_gRPC_tester/proto/unique_ids"
 )
 
@@ -27,182 +39,420 @@ type server struct {
 	echopb.UnimplementedEchoServiceServer
 	uniqueidpb.UnimplementedUniqueIdsServiceServer
 	broadcastpb.UnimplementedBroadcastServiceServer
+	nemesispb.UnimplementedNemesisServiceServer
+	processpb.UnimplementedProcessServiceServer
+	maelstrompb.UnimplementedMessageServiceServer
+	gsetpb.UnimplementedGSetServiceServer
+	counterpb.UnimplementedCounterServiceServer
+	kafkapb.UnimplementedKafkaServiceServer
+	txnpb.UnimplementedTxnServiceServer
+	kvpb.UnimplementedKVServiceServer
+	statspb.UnimplementedStatsServiceServer
+
+	config *config
+	launch *launchSpec
+	nodes  map[string]*node
+	mu     sync.RWMutex
+	// initSrc and initIDs are the client and node IDs of the last init, which
+	// is sent again to nodes that are restarted.
+	initSrc string
+	initIDs []string
+
+	nextMsgID atomic.Int64
+	pending   map[int64]*pendingCall
+	pendingMu sync.Mutex
+
+	faults *faults
+	clocks *clocks
+	// services are the built-in key-value services of the running cluster,
+	// started afresh from kvSeed, or from the time if it is zero, by every
+	// init.
+	services map[string]*kvService
+	kvSeed   int64
+	netStats *netStats
+	// sim routes the messages of a seeded cluster; it is nil otherwise.
+	sim *simulation
+}
+
+// replyTimeout bounds how long an RPC waits for the node's reply when the
+// caller did not set a deadline of its own.
+const replyTimeout = 5 * time.Second
 
-	binaryName string
-	stdinPipe  *os.File
-	stdoutPipe *bufio.Reader
-	mu         sync.Mutex
+// pendingCall is an RPC waiting for the node to answer the message it sent.
+type pendingCall struct {
+	clientMsgID json.RawMessage
+	reply       chan *message
 }
 
-func (s *server) captureOutput() {
-	scanner := bufio.NewScanner(s.stdoutPipe)
+// maxLineSize is the longest line a node may write, which bounds the size of
+// a single message.
+const maxLineSize = 16 << 20
+
+func (s *server) captureOutput(n *node) {
+	defer close(n.done)
+
+	scanner := bufio.NewScanner(n.stdout)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
 	for scanner.Scan() {
-		log.Printf("binary output: %s", scanner.Text())
+		line := scanner.Bytes()
+
+		if !bytes.HasPrefix(bytes.TrimSpace(line), []byte("{")) {
+			log.Printf("%s output: %s", n.id, line)
+			continue
+		}
+
+		msg, err := parseMessage(line)
+		if err != nil {
+			log.Printf("invalid message from %s: %v: %s", n.id, err, line)
+			continue
+		}
+		if msg.Src != n.id {
+			log.Printf("invalid message from %s: src is %q: %s", n.id, msg.Src, line)
+			continue
+		}
+
+		if sim := s.simulation(); sim != nil {
+			sim.receive(msg, line)
+		} else {
+			s.route(msg, line)
+		}
 	}
 	if err := scanner.Err(); err != nil {
-		log.Printf("error reading from binary output: %v", err)
+		log.Printf("error reading from %s output: %v", n.id, err)
 	}
 }
 
-func (s *server) writeToStdin(in interface{}) error {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// call sends in to its dest node and decodes the node's reply into out. A
+// Maelstrom error reply is returned as the matching gRPC status, and any
+// reply other than the request type's "_ok" is reported as a mismatch.
+func (s *server) call(ctx context.Context, in proto.Message, out proto.Message) error {
+	reply, err := s.exchange(ctx, in)
+	if err != nil {
+		return err
+	}
+	if err := decodeMessage(reply, out); err != nil {
+		return status.Errorf(codes.Internal, "%v", err)
+	}
 
-	message, err := json.Marshal(in)
+	return nil
+}
+
+// exchange sends in to its dest node and returns the reply, once it has
+// checked that the reply is the matching _ok type rather than an error.
+func (s *server) exchange(ctx context.Context, in proto.Message) (*message, error) {
+	msg, err := encodeMessage(in)
 	if err != nil {
-		return fmt.Errorf("failed to marshal message: %w", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
 	}
+	reqType := msg.bodyType()
 
-	_, err = s.stdinPipe.WriteString(string(message) + "\n")
+	reply, err := s.roundTrip(ctx, msg)
 	if err != nil {
-		return fmt.Errorf("failed to write to stdin: %w", err)
+		return nil, err
+	}
+	if err := reply.errorStatus(); err != nil {
+		return nil, err
 	}
-	// fix
-	// println("writing: ", in, string(message))
 
-	return nil
+	if want := reqType + "_ok"; reply.bodyType() != want {
+		return nil, status.Errorf(codes.Internal, "expected %s reply from %s, got %s", want, reply.Src, reply.bodyType())
+	}
+	return reply, nil
+}
+
+// roundTrip sends msg to its dest node under a server-assigned msg_id and
+// waits for the reply. The client's own msg_id, if any, is restored as the
+// reply's in_reply_to so callers can still correlate on their side.
+func (s *server) roundTrip(ctx context.Context, msg *message) (*message, error) {
+	msgID := s.nextMsgID.Add(1)
+	pc := &pendingCall{
+		clientMsgID: msg.Body["msg_id"],
+		reply:       make(chan *message, 1),
+	}
+	msg.setBodyInt("msg_id", msgID)
+
+	s.pendingMu.Lock()
+	s.pending[msgID] = pc
+	s.pendingMu.Unlock()
+
+	defer func() {
+		s.pendingMu.Lock()
+		delete(s.pending, msgID)
+		s.pendingMu.Unlock()
+	}()
+
+	n := s.node(msg.Dest)
+	if n == nil {
+		return nil, status.Errorf(codes.NotFound, "no node %q in the cluster", msg.Dest)
+	}
+	if !n.running() {
+		return nil, status.Errorf(codes.Unavailable, "node %s %s", n.id, n.exitDescription())
+	}
+	if sim := s.simulation(); sim != nil {
+		sim.request(msg)
+	} else if err := n.write(msg); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "%v", err)
+	}
+	s.netStats.clientOp(msg)
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, replyTimeout)
+		defer cancel()
+	}
+
+	var reply *message
+	select {
+	case reply = <-pc.reply:
+	case <-n.exited:
+		// Output is drained before exited closes, so a reply the node managed
+		// to write is already waiting.
+		select {
+		case reply = <-pc.reply:
+		default:
+			return nil, status.Errorf(codes.Unavailable, "node %s %s before replying to msg_id %d", n.id, n.exitDescription(), msgID)
+		}
+	case <-ctx.Done():
+		return nil, status.Errorf(status.FromContextError(ctx.Err()).Code(),
+			"no reply from %s to msg_id %d: %v", msg.Dest, msgID, ctx.Err())
+	}
+
+	if pc.clientMsgID != nil {
+		reply.Body["in_reply_to"] = pc.clientMsgID
+	}
+
+	return reply, nil
 }
 
+// SendInit starts one process per entry in node_ids and sends each its own
+// init message. The reply returned is the one from in.Dest, or from the first
+// node if in.Dest is not part of the cluster.
 func (s *server) SendInit(ctx context.Context, in *initpb.InitRequest) (*initpb.InitResponse, error) {
-	if err := s.writeToStdin(in); err != nil {
+	nodeIDs := in.GetBody().GetNodeIds()
+	if len(nodeIDs) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "init must list at least one node in node_ids")
+	}
+
+	if err := s.startCluster(in.Src, nodeIDs); err != nil {
 		return nil, err
 	}
 
-	return &initpb.InitResponse{
-		Src:  in.Dest,
-		Dest: in.Src,
-		Body: &initpb.InitResponseBody{
-			Type:      "init_ok",
-			MsgId:     0,
-			InReplyTo: nil,
-		},
-	}, nil
+	replies := make([]*initpb.InitResponse, len(nodeIDs))
+	errs := make([]error, len(nodeIDs))
+	s.forEach(len(nodeIDs), func(i int) {
+		req := &initpb.InitRequest{
+			Src:  in.Src,
+			Dest: nodeIDs[i],
+			Body: &initpb.InitRequestBody{
+				Type:    in.Body.Type,
+				NodeId:  nodeIDs[i],
+				NodeIds: nodeIDs,
+			},
+		}
+		replies[i] = &initpb.InitResponse{}
+		errs[i] = s.call(ctx, req, replies[i])
+	})
+
+	out := replies[0]
+	for i, id := range nodeIDs {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if id == in.Dest {
+			out = replies[i]
+		}
+	}
+
+	return out, nil
 }
 
 func (s *server) SendEcho(ctx context.Context, in *echopb.EchoRequest) (*echopb.EchoResponse, error) {
-	if err := s.writeToStdin(in); err != nil {
+	out := &echopb.EchoResponse{}
+	if err := s.call(ctx, in, out); err != nil {
 		return nil, err
 	}
-
-	return &echopb.EchoResponse{
-		Src:  in.Dest,
-		Dest: in.Src,
-		Body: &echopb.EchoResponseBody{
-			Type:      "echo_ok",
-			Echo:      in.Body.Echo,
-			MsgId:     in.Body.MsgId,
-			InReplyTo: in.Body.MsgId,
-		},
-	}, nil
+	return out, nil
 }
 
 func (s *server) SendUniqueIds(ctx context.Context, in *uniqueidpb.UniqueIdsRequest) (*uniqueidpb.UniqueIdsResponse, error) {
-	if err := s.writeToStdin(in); err != nil {
+	reply, err := s.exchange(ctx, in)
+	if err != nil {
 		return nil, err
 	}
 
-	return &uniqueidpb.UniqueIdsResponse{
-		Src:  in.Dest,
-		Dest: in.Src,
-		Body: &uniqueidpb.UniqueIdsResponseBody{
-			Type: "generate_ok",
-			Id:   1,
-		},
-	}, nil
+	// The proto carries IDs as strings. Integer IDs are passed on as their
+	// exact decimal text, which also keeps 64-bit values out of float64.
+	if id := bytes.TrimSpace(reply.Body["id"]); len(id) > 0 && id[0] != '"' {
+		var n json.Number
+		if err := json.Unmarshal(id, &n); err == nil {
+			reply.Body["id"], _ = json.Marshal(n.String())
+		}
+	}
+
+	out := &uniqueidpb.UniqueIdsResponse{}
+	if err := decodeMessage(reply, out); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return out, nil
 }
 
 func (s *server) SendBroadcast(ctx context.Context, in *broadcastpb.BroadcastRequest) (*broadcastpb.BroadcastResponse, error) {
-	if err := s.writeToStdin(in); err != nil {
+	out := &broadcastpb.BroadcastResponse{}
+	if err := s.call(ctx, in, out); err != nil {
 		return nil, err
 	}
-
-	return &broadcastpb.BroadcastResponse{
-		Src:  in.Dest,
-		Dest: in.Src,
-		Body: &broadcastpb.BroadcastResponseBody{
-			Type: "broadcast_ok",
-		},
-	}, nil
+	return out, nil
 }
 
 func (s *server) SendRead(ctx context.Context, in *broadcastpb.ReadRequest) (*broadcastpb.ReadResponse, error) {
-	if err := s.writeToStdin(in); err != nil {
+	out := &broadcastpb.ReadResponse{}
+	if err := s.call(ctx, in, out); err != nil {
 		return nil, err
 	}
-
-	return &broadcastpb.ReadResponse{
-		Src:  in.Dest,
-		Dest: in.Src,
-		Body: &broadcastpb.ReadResponseBody{
-			Type:     "read_ok",
-			Messages: []int32{1, 2, 3},
-		},
-	}, nil
+	return out, nil
 }
 
 func (s *server) SendTopology(ctx context.Context, in *broadcastpb.TopologyRequest) (*broadcastpb.TopologyResponse, error) {
-	if err := s.writeToStdin(in); err != nil {
+	out := &broadcastpb.TopologyResponse{}
+	if err := s.call(ctx, in, out); err != nil {
 		return nil, err
 	}
-
-	return &broadcastpb.TopologyResponse{
-		Src:  in.Dest,
-		Dest: in.Src,
-		Body: &broadcastpb.TopologyResponseBody{
-			Type: "topology_ok",
-		},
-	}, nil
+	return out, nil
 }
 
+// SetBinaryName selects a binary from the binaries directory, with no
+// arguments, for SendInit to launch for every node.
 func (s *server) SetBinaryName(ctx context.Context, in *initpb.SetBinaryNameRequest) (*initpb.SetBinaryNameResponse, error) {
+	if _, err := s.Launch(ctx, &initpb.LaunchRequest{Path: in.BinaryName}); err != nil {
+		return nil, err
+	}
+
+	return &initpb.SetBinaryNameResponse{}, nil
+}
+
+// Launch sets the binary, arguments, environment and working directory that
+// SendInit uses for every node, and whether the cluster is a seeded
+// simulation.
+func (s *server) Launch(ctx context.Context, in *initpb.LaunchRequest) (*initpb.LaunchResponse, error) {
+	path, err := s.config.resolveBinary(in.Path)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid binary: %v", err)
+	}
+
+	// The running cluster belongs to the previous binary; the next init
+	// starts a new one, with clocks that are not skewed until SetClock.
+	s.stopCluster()
+	s.clocks.reset()
+	s.startSimulation(in.Seed)
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	s.binaryName = in.BinaryName
-	binaryPath := fmt.Sprintf("/home/shrestha/rust/distributed_systems/target/debug/%s", s.binaryName)
-	rustCmd := exec.Command(binaryPath)
+	s.launch = &launchSpec{
+		path: path,
+		args: in.Args,
+		env:  in.Env,
+		dir:  in.WorkingDir,
+	}
 
-	stdinPipe, err := rustCmd.StdinPipe()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get stdin pipe: %v", err)
+	return &initpb.LaunchResponse{Path: path}, nil
+}
+
+// startCluster replaces any running cluster with one process per node ID,
+// to be initialized by client src.
+func (s *server) startCluster(src string, nodeIDs []string) error {
+	seen := make(map[string]bool, len(nodeIDs))
+	for _, id := range nodeIDs {
+		if seen[id] {
+			return status.Errorf(codes.InvalidArgument, "duplicate node ID %q", id)
+		}
+		seen[id] = true
 	}
 
-	stdoutPipe, err := rustCmd.StdoutPipe()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get stdout pipe: %v", err)
+	s.stopCluster()
+	s.netStats.reset()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.launch == nil {
+		return status.Errorf(codes.FailedPrecondition, "Launch or SetBinaryName must be called before init")
+	}
+	s.initSrc = src
+	s.initIDs = nodeIDs
+
+	kvSeed := s.kvSeed
+	if kvSeed == 0 {
+		kvSeed = time.Now().UnixNano()
 	}
+	stopKVServices(s.services)
+	s.services = newKVServices(kvSeed, s.sim == nil)
 
-	rustCmd.Stderr = os.Stderr
+	for _, id := range nodeIDs {
+		n, err := startNode(id, s.launch, s.clocks.env(id))
+		if err != nil {
+			return status.Errorf(codes.Internal, "%v", err)
+		}
+		s.nodes[id] = n
 
-	if err := rustCmd.Start(); err != nil {
-		return nil, fmt.Errorf("failed to start the binary: %v", err)
+		go s.captureOutput(n) // Ensure output is captured
 	}
 
-	s.stdinPipe = stdinPipe.(*os.File)
-	s.stdoutPipe = bufio.NewReader(stdoutPipe)
+	return nil
+}
 
-	go s.captureOutput() // Ensure output is captured
+// stopCluster removes every node from the cluster and kills its process. It
+// must not be called with s.mu held: a node's output has to be drained, which
+// goes through the router, before the process counts as stopped.
+func (s *server) stopCluster() {
+	s.mu.Lock()
+	nodes := s.nodes
+	s.nodes = make(map[string]*node)
+	s.mu.Unlock()
 
-	return &initpb.SetBinaryNameResponse{}, nil
+	for _, n := range nodes {
+		n.stop(0)
+	}
 }
 
 func main() {
-	s := &server{}
+	cfg, err := loadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	s := &server{
+		config:   cfg,
+		nodes:    make(map[string]*node),
+		pending:  make(map[int64]*pendingCall),
+		faults:   newFaults(),
+		clocks:   newClocks(),
+		netStats: newNetStats(),
+	}
 
 	grpcServer := grpc.NewServer()
 	initpb.RegisterInitServiceServer(grpcServer, s)
 	echopb.RegisterEchoServiceServer(grpcServer, s)
 	uniqueidpb.RegisterUniqueIdsServiceServer(grpcServer, s)
 	broadcastpb.RegisterBroadcastServiceServer(grpcServer, s)
+	nemesispb.RegisterNemesisServiceServer(grpcServer, s)
+	processpb.RegisterProcessServiceServer(grpcServer, s)
+	maelstrompb.RegisterMessageServiceServer(grpcServer, s)
+	gsetpb.RegisterGSetServiceServer(grpcServer, s)
+	counterpb.RegisterCounterServiceServer(grpcServer, s)
+	kafkapb.RegisterKafkaServiceServer(grpcServer, s)
+	txnpb.RegisterTxnServiceServer(grpcServer, s)
+	kvpb.RegisterKVServiceServer(grpcServer, s)
+	statspb.RegisterStatsServiceServer(grpcServer, s)
 
 	reflection.Register(grpcServer)
 
-	lis, err := net.Listen("tcp", ":5051")
+	lis, err := net.Listen("tcp", cfg.Addr)
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	log.Printf("Server is listening on :5051")
+	log.Printf("Server is listening on %s, launching binaries from %s", cfg.Addr, cfg.BinDir)
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// message is a Maelstrom envelope as it travels over a node's stdin/stdout.
+// The body is kept as raw fields so the server can rewrite msg_id and
+// in_reply_to without knowing the workload's schema.
+type message struct {
+	Src  string                     `json:"src"`
+	Dest string                     `json:"dest"`
+	Body map[string]json.RawMessage `json:"body"`
+}
+
+// wireFormat renders protos the way Maelstrom expects: snake_case field names
+// and zero values such as msg_id 0 or an empty message list kept in place.
+// Wire protos stick to 32-bit integers, since protojson quotes 64-bit ones.
+var wireFormat = protojson.MarshalOptions{
+	UseProtoNames:   true,
+	EmitUnpopulated: true,
+}
+
+// parseMessage parses one line of node output and checks that it has the
+// shape of a Maelstrom message.
+func parseMessage(line []byte) (*message, error) {
+	var msg message
+	if err := json.Unmarshal(line, &msg); err != nil {
+		return nil, fmt.Errorf("failed to parse message: %w", err)
+	}
+	if msg.Src == "" || msg.Dest == "" {
+		return nil, fmt.Errorf("message is missing src or dest")
+	}
+	if msg.Body == nil {
+		return nil, fmt.Errorf("message has no body")
+	}
+	if msg.bodyType() == "" {
+		return nil, fmt.Errorf("message body has no type")
+	}
+	return &msg, nil
+}
+
+// encodeMessage converts a request proto into the message written to a node.
+func encodeMessage(in proto.Message) (*message, error) {
+	raw, err := wireFormat.Marshal(in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	return parseMessage(raw)
+}
+
+// decodeMessage strictly decodes a node's message into a response proto, so
+// fields the proto does not know about are reported rather than dropped.
+func decodeMessage(msg *message, out proto.Message) error {
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+	if err := protojson.Unmarshal(raw, out); err != nil {
+		return fmt.Errorf("%s from %s does not match %s: %w",
+			msg.bodyType(), msg.Src, out.ProtoReflect().Descriptor().Name(), err)
+	}
+	return nil
+}
+
+func (m *message) bodyType() string {
+	var t string
+	json.Unmarshal(m.Body["type"], &t)
+	return t
+}
+
+// bodyInt reads an integer body field such as msg_id or in_reply_to.
+func (m *message) bodyInt(field string) (int64, bool) {
+	raw, ok := m.Body[field]
+	if !ok {
+		return 0, false
+	}
+	var v int64
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+func (m *message) setBodyInt(field string, v int64) {
+	m.Body[field] = json.RawMessage(fmt.Sprintf("%d", v))
+}
+
+// errorStatus converts a Maelstrom error body into a gRPC status, or returns
+// nil if the message is not an error.
+func (m *message) errorStatus() error {
+	if m.bodyType() != "error" {
+		return nil
+	}
+
+	var body struct {
+		Code int    `json:"code"`
+		Text string `json:"text"`
+	}
+	raw, _ := json.Marshal(m.Body)
+	json.Unmarshal(raw, &body)
+
+	return status.Errorf(maelstromCode(body.Code), "node %s returned error %d: %s", m.Src, body.Code, body.Text)
+}
+
+// maelstromCode maps Maelstrom's error codes onto the closest gRPC code.
+func maelstromCode(code int) codes.Code {
+	switch code {
+	case 0:
+		return codes.DeadlineExceeded
+	case 1, 20:
+		return codes.NotFound
+	case 10:
+		return codes.Unimplemented
+	case 11:
+		return codes.Unavailable
+	case 12:
+		return codes.InvalidArgument
+	case 13:
+		return codes.Internal
+	case 14, 30:
+		return codes.Aborted
+	case 21:
+		return codes.AlreadyExists
+	case 22:
+		return codes.FailedPrecondition
+	default:
+		return codes.Unknown
+	}
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	nemesispb "github.com/Shresth72/go_gRPC_tester/proto/nemesis"
+)
+
+// faults is the network fault layer applied to inter-node traffic.
+type faults struct {
+	mu  sync.Mutex
+	rng *rand.Rand
+
+	// groups maps a node ID to its side of the current partition. Nodes that
+	// are absent are not partitioned from anyone.
+	groups map[string]int
+
+	drop          float64
+	duplicate     float64
+	reorder       float64
+	latency       time.Duration
+	jitter        time.Duration
+	reorderWindow time.Duration
+	config        *nemesispb.Faults
+}
+
+func newFaults() *faults {
+	return &faults{
+		rng:    rand.New(rand.NewSource(time.Now().UnixNano())),
+		groups: make(map[string]int),
+		config: &nemesispb.Faults{},
+	}
+}
+
+// seed restarts the random draws of drops, duplicates and delays from seed.
+func (f *faults) seed(seed int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.rng = rand.New(rand.NewSource(seed))
+}
+
+// plan decides the fate of one message from src to dest. It returns the
+// delay of each copy to deliver: none if the message is dropped, more than
+// one if it is duplicated.
+func (f *faults) plan(src, dest string) []time.Duration {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	srcGroup, srcOK := f.groups[src]
+	destGroup, destOK := f.groups[dest]
+	if srcOK && destOK && srcGroup != destGroup {
+		return nil
+	}
+
+	if f.rng.Float64() < f.drop {
+		return nil
+	}
+
+	copies := 1
+	if f.rng.Float64() < f.duplicate {
+		copies = 2
+	}
+
+	delays := make([]time.Duration, copies)
+	for i := range delays {
+		delays[i] = f.delay()
+	}
+
+	return delays
+}
+
+// delay draws one delivery delay. Callers must hold f.mu.
+func (f *faults) delay() time.Duration {
+	d := f.latency
+	if f.jitter > 0 {
+		d += time.Duration(f.rng.Int63n(int64(f.jitter)))
+	}
+	if f.reorderWindow > 0 && f.rng.Float64() < f.reorder {
+		d += time.Duration(f.rng.Int63n(int64(f.reorderWindow)))
+	}
+
+	return d
+}
+
+func (f *faults) partition(groups []*nemesispb.NodeGroup) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.groups = make(map[string]int)
+	for i, g := range groups {
+		for _, id := range g.Nodes {
+			f.groups[id] = i
+		}
+	}
+}
+
+func (f *faults) set(c *nemesispb.Faults) error {
+	for _, p := range []float64{c.DropProbability, c.DuplicateProbability, c.ReorderProbability} {
+		if p < 0 || p > 1 {
+			return status.Errorf(codes.InvalidArgument, "probability %v is outside [0, 1]", p)
+		}
+	}
+	for _, ms := range []int32{c.LatencyMs, c.JitterMs, c.ReorderWindowMs} {
+		if ms < 0 {
+			return status.Errorf(codes.InvalidArgument, "duration %dms is negative", ms)
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.drop = c.DropProbability
+	f.duplicate = c.DuplicateProbability
+	f.reorder = c.ReorderProbability
+	f.latency = time.Duration(c.LatencyMs) * time.Millisecond
+	f.jitter = time.Duration(c.JitterMs) * time.Millisecond
+	f.reorderWindow = time.Duration(c.ReorderWindowMs) * time.Millisecond
+	f.config = c
+
+	return nil
+}
+
+func (f *faults) snapshot() (*nemesispb.Faults, []*nemesispb.NodeGroup) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	byGroup := make(map[int][]string)
+	n := 0
+	for id, g := range f.groups {
+		byGroup[g] = append(byGroup[g], id)
+		if g+1 > n {
+			n = g + 1
+		}
+	}
+
+	groups := make([]*nemesispb.NodeGroup, n)
+	for i := range groups {
+		groups[i] = &nemesispb.NodeGroup{Nodes: byGroup[i]}
+	}
+
+	return f.config, groups
+}
+
+func (s *server) Partition(ctx context.Context, in *nemesispb.PartitionRequest) (*nemesispb.PartitionResponse, error) {
+	s.faults.partition(in.Groups)
+	return &nemesispb.PartitionResponse{}, nil
+}
+
+func (s *server) Heal(ctx context.Context, in *nemesispb.HealRequest) (*nemesispb.HealResponse, error) {
+	s.faults.partition(nil)
+	return &nemesispb.HealResponse{}, nil
+}
+
+func (s *server) SetFaults(ctx context.Context, in *nemesispb.SetFaultsRequest) (*nemesispb.SetFaultsResponse, error) {
+	if in.Faults == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "faults must be set")
+	}
+	if err := s.faults.set(in.Faults); err != nil {
+		return nil, err
+	}
+	return &nemesispb.SetFaultsResponse{}, nil
+}
+
+func (s *server) GetFaults(ctx context.Context, in *nemesispb.GetFaultsRequest) (*nemesispb.GetFaultsResponse, error) {
+	f, groups := s.faults.snapshot()
+	return &nemesispb.GetFaultsResponse{
+		Faults: f,
+		Groups: groups,
+	}, nil
+}
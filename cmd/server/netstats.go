@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	statspb "github.com/Shresth72/go_gRPC_tester/proto/stats"
+)
+
+// link is a directed pair of nodes.
+type link struct {
+	src, dest string
+}
+
+// count is a number of messages and their total size in bytes.
+type count struct {
+	messages, bytes int64
+}
+
+func (c *count) add(bytes int) {
+	c.messages++
+	c.bytes += int64(bytes)
+}
+
+// netStats counts the traffic the server routes: requests from clients,
+// messages between nodes, and requests from nodes to built-in services.
+type netStats struct {
+	mu sync.Mutex
+
+	clientOps int64
+	total     count
+	dropped   int64
+	services  int64
+	byType    map[string]*count
+	byLink    map[link]*count
+}
+
+func newNetStats() *netStats {
+	st := &netStats{}
+	st.reset()
+	return st
+}
+
+func (st *netStats) reset() {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.clientOps = 0
+	st.total = count{}
+	st.dropped = 0
+	st.services = 0
+	st.byType = make(map[string]*count)
+	st.byLink = make(map[link]*count)
+}
+
+// setupTypes are the requests that set a node up rather than exercise it:
+// the server's init and clock messages and the tester's topology. They are
+// not counted as client operations.
+var setupTypes = map[string]bool{
+	"init":     true,
+	"topology": true,
+	"clock":    true,
+}
+
+// clientOp counts one request sent to a node on behalf of a client, unless
+// msg is one of the setupTypes.
+func (st *netStats) clientOp(msg *message) {
+	if setupTypes[msg.bodyType()] {
+		return
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.clientOps++
+}
+
+// serviceMessage counts one request from a node to a built-in service.
+func (st *netStats) serviceMessage() {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.services++
+}
+
+// nodeMessage counts one message of size bytes sent from one node to
+// another, and whether the fault layer dropped it.
+func (st *netStats) nodeMessage(msg *message, bytes int, dropped bool) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.total.add(bytes)
+	if dropped {
+		st.dropped++
+	}
+
+	t := msg.bodyType()
+	if st.byType[t] == nil {
+		st.byType[t] = &count{}
+	}
+	st.byType[t].add(bytes)
+
+	l := link{msg.Src, msg.Dest}
+	if st.byLink[l] == nil {
+		st.byLink[l] = &count{}
+	}
+	st.byLink[l].add(bytes)
+}
+
+func (st *netStats) snapshot() *statspb.GetNetStatsResponse {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	out := &statspb.GetNetStatsResponse{
+		ClientOperations: st.clientOps,
+		Messages:         st.total.messages,
+		Bytes:            st.total.bytes,
+		Dropped:          st.dropped,
+		ServiceMessages:  st.services,
+	}
+	if st.clientOps > 0 {
+		out.MessagesPerOperation = float64(st.total.messages) / float64(st.clientOps)
+	}
+
+	for t, c := range st.byType {
+		out.ByType = append(out.ByType, &statspb.MessageCount{Key: t, Messages: c.messages, Bytes: c.bytes})
+	}
+	sort.Slice(out.ByType, func(i, j int) bool { return out.ByType[i].Key < out.ByType[j].Key })
+
+	for l, c := range st.byLink {
+		out.Links = append(out.Links, &statspb.LinkCount{Src: l.src, Dest: l.dest, Messages: c.messages, Bytes: c.bytes})
+	}
+	sort.Slice(out.Links, func(i, j int) bool {
+		a, b := out.Links[i], out.Links[j]
+		return a.Src < b.Src || a.Src == b.Src && a.Dest < b.Dest
+	})
+
+	return out
+}
+
+func (s *server) GetNetStats(ctx context.Context, in *statspb.GetNetStatsRequest) (*statspb.GetNetStatsResponse, error) {
+	return s.netStats.snapshot(), nil
+}
+
+func (s *server) ResetNetStats(ctx context.Context, in *statspb.ResetNetStatsRequest) (*statspb.ResetNetStatsResponse, error) {
+	s.netStats.reset()
+	return &statspb.ResetNetStatsResponse{}, nil
+}
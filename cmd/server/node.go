@@ -0,0 +1,206 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// node is one child process of the cluster, addressed by its Maelstrom node ID.
+type node struct {
+	id     string
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout io.ReadCloser
+	done   chan struct{} // closed once stdout has been drained
+
+	started  time.Time
+	restarts int
+	exited   chan struct{} // closed once the process has been reaped
+	exitErr  error
+
+	mu sync.Mutex // serializes writes to stdin
+
+	// queue holds forwarded messages so that reading one node's stdout never
+	// blocks on writing another node's stdin.
+	queue   []*message
+	queueMu sync.Mutex
+	wake    chan struct{}
+}
+
+// launchSpec describes how to start the process behind each node.
+type launchSpec struct {
+	path string
+	args []string
+	env  map[string]string
+	dir  string
+}
+
+// startNode starts the process for node id, adding env, in KEY=value form,
+// to the environment given by spec.
+func startNode(id string, spec *launchSpec, env []string) (*node, error) {
+	cmd := exec.Command(spec.path, spec.args...)
+	cmd.Dir = spec.dir
+	cmd.Env = os.Environ()
+	for k, v := range spec.env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	cmd.Env = append(cmd.Env, env...)
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stdin pipe for %s: %v", id, err)
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stdout pipe for %s: %v", id, err)
+	}
+
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start %s for %s: %v", spec.path, id, err)
+	}
+
+	n := &node{
+		id:      id,
+		cmd:     cmd,
+		stdin:   stdin,
+		stdout:  stdout,
+		done:    make(chan struct{}),
+		started: time.Now(),
+		exited:  make(chan struct{}),
+		wake:    make(chan struct{}, 1),
+	}
+	go n.deliverQueued()
+	go n.wait()
+
+	return n, nil
+}
+
+func (n *node) write(msg *message) error {
+	line, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal message: %w", err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if _, err := n.stdin.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write to %s stdin: %w", n.id, err)
+	}
+
+	return nil
+}
+
+// enqueue schedules msg for delivery to the node without waiting for the
+// write.
+func (n *node) enqueue(msg *message) {
+	n.queueMu.Lock()
+	n.queue = append(n.queue, msg)
+	n.queueMu.Unlock()
+
+	select {
+	case n.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (n *node) deliverQueued() {
+	for {
+		select {
+		case <-n.wake:
+		case <-n.done:
+			return
+		}
+
+		n.queueMu.Lock()
+		queued := n.queue
+		n.queue = nil
+		n.queueMu.Unlock()
+
+		for _, msg := range queued {
+			if err := n.write(msg); err != nil {
+				log.Printf("failed to deliver %s from %s: %v", msg.bodyType(), msg.Src, err)
+			}
+		}
+	}
+}
+
+// wait reaps the process once its output has been drained, as exec.Cmd
+// requires, and records how it exited.
+func (n *node) wait() {
+	<-n.done
+	n.exitErr = n.cmd.Wait()
+	close(n.exited)
+
+	log.Printf("node %s (pid %d) %s", n.id, n.cmd.Process.Pid, n.exitDescription())
+}
+
+func (n *node) running() bool {
+	select {
+	case <-n.exited:
+		return false
+	default:
+		return true
+	}
+}
+
+// exitDescription says how the process ended, for logs and errors.
+func (n *node) exitDescription() string {
+	if n.running() {
+		return "is still running"
+	}
+
+	code, sig := n.exitStatus()
+	if sig != "" {
+		return "was killed by " + sig
+	}
+	return fmt.Sprintf("exited with code %d", code)
+}
+
+// exitStatus returns the exit code and terminating signal of an exited
+// process.
+func (n *node) exitStatus() (int, string) {
+	ps := n.cmd.ProcessState
+	if ps == nil {
+		return -1, ""
+	}
+
+	if ws, ok := ps.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+		return -1, signalName(ws.Signal())
+	}
+	return ps.ExitCode(), ""
+}
+
+func (n *node) signal(sig syscall.Signal) error {
+	if !n.running() {
+		return fmt.Errorf("node %s %s", n.id, n.exitDescription())
+	}
+	return n.cmd.Process.Signal(sig)
+}
+
+// stop asks the process to terminate, kills it if it is still running after
+// grace, and waits for it to be reaped.
+func (n *node) stop(grace time.Duration) {
+	n.stdin.Close()
+
+	if grace > 0 && n.signal(syscall.SIGTERM) == nil {
+		select {
+		case <-n.exited:
+			return
+		case <-time.After(grace):
+		}
+	}
+
+	n.cmd.Process.Kill()
+	<-n.exited
+}
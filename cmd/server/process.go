@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	initpb "github.com/Shresth72/go_gRPC_tester/proto/init"
+	processpb "github.com/Shresth72/go_gRPC_tester/proto/process"
+)
+
+// stopGrace is how long Stop waits after SIGTERM before killing a node.
+const stopGrace = 2 * time.Second
+
+var signals = map[string]syscall.Signal{
+	"SIGHUP":  syscall.SIGHUP,
+	"SIGINT":  syscall.SIGINT,
+	"SIGQUIT": syscall.SIGQUIT,
+	"SIGKILL": syscall.SIGKILL,
+	"SIGUSR1": syscall.SIGUSR1,
+	"SIGUSR2": syscall.SIGUSR2,
+	"SIGTERM": syscall.SIGTERM,
+	"SIGCONT": syscall.SIGCONT,
+	"SIGSTOP": syscall.SIGSTOP,
+}
+
+// parseSignal accepts "SIGKILL", "kill" or "9".
+func parseSignal(name string) (syscall.Signal, error) {
+	if n, err := strconv.Atoi(name); err == nil {
+		return syscall.Signal(n), nil
+	}
+
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "SIG") {
+		name = "SIG" + name
+	}
+	if sig, ok := signals[name]; ok {
+		return sig, nil
+	}
+
+	return 0, fmt.Errorf("unknown signal %q", name)
+}
+
+func signalName(sig syscall.Signal) string {
+	for name, s := range signals {
+		if s == sig {
+			return name
+		}
+	}
+	return sig.String()
+}
+
+func (n *node) status() *processpb.ProcessStatus {
+	ps := &processpb.ProcessStatus{
+		NodeId:   n.id,
+		Running:  n.running(),
+		Pid:      int32(n.cmd.Process.Pid),
+		Restarts: int32(n.restarts),
+	}
+
+	if ps.Running {
+		ps.UptimeMs = time.Since(n.started).Milliseconds()
+	} else {
+		code, sig := n.exitStatus()
+		ps.ExitCode = int32(code)
+		ps.Signal = sig
+	}
+
+	return ps
+}
+
+// restartNode starts a new process for a node that is not running, keeping
+// its restart count. The caller sends it init with reinit.
+func (s *server) restartNode(id string) (*node, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, ok := s.nodes[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no node %q in the cluster", id)
+	}
+	if old.running() {
+		return nil, status.Errorf(codes.FailedPrecondition, "node %s is already running", id)
+	}
+
+	n, err := startNode(id, s.launch, s.clocks.env(id))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	n.restarts = old.restarts + 1
+	s.nodes[id] = n
+
+	go s.captureOutput(n)
+
+	return n, nil
+}
+
+// reinit sends a restarted node the init message the cluster was started
+// with, so that it rejoins without the client's help.
+func (s *server) reinit(ctx context.Context, n *node) error {
+	s.mu.RLock()
+	src, ids := s.initSrc, s.initIDs
+	s.mu.RUnlock()
+
+	req := &initpb.InitRequest{
+		Src:  src,
+		Dest: n.id,
+		Body: &initpb.InitRequestBody{
+			Type:    "init",
+			NodeId:  n.id,
+			NodeIds: ids,
+		},
+	}
+	if err := s.call(ctx, req, &initpb.InitResponse{}); err != nil {
+		return status.Errorf(status.Code(err), "node %s restarted, but init failed: %v", n.id, err)
+	}
+	return nil
+}
+
+func (s *server) lookupNode(id string) (*node, error) {
+	n := s.node(id)
+	if n == nil {
+		return nil, status.Errorf(codes.NotFound, "no node %q in the cluster", id)
+	}
+	return n, nil
+}
+
+func (s *server) Start(ctx context.Context, in *processpb.StartRequest) (*processpb.StartResponse, error) {
+	n, err := s.restartNode(in.NodeId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.reinit(ctx, n); err != nil {
+		return nil, err
+	}
+	return &processpb.StartResponse{Process: n.status()}, nil
+}
+
+func (s *server) Stop(ctx context.Context, in *processpb.StopRequest) (*processpb.StopResponse, error) {
+	n, err := s.lookupNode(in.NodeId)
+	if err != nil {
+		return nil, err
+	}
+	n.stop(stopGrace)
+	return &processpb.StopResponse{Process: n.status()}, nil
+}
+
+func (s *server) Restart(ctx context.Context, in *processpb.RestartRequest) (*processpb.RestartResponse, error) {
+	n, err := s.lookupNode(in.NodeId)
+	if err != nil {
+		return nil, err
+	}
+	n.stop(stopGrace)
+
+	n, err = s.restartNode(in.NodeId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.reinit(ctx, n); err != nil {
+		return nil, err
+	}
+	return &processpb.RestartResponse{Process: n.status()}, nil
+}
+
+func (s *server) Kill(ctx context.Context, in *processpb.KillRequest) (*processpb.KillResponse, error) {
+	n, err := s.lookupNode(in.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	sigName := in.Signal
+	if sigName == "" {
+		sigName = "SIGKILL"
+	}
+	sig, err := parseSignal(sigName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if err := n.signal(sig); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	// Give a fatal signal a moment to take effect so the status reflects it.
+	select {
+	case <-n.exited:
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	return &processpb.KillResponse{Process: n.status()}, nil
+}
+
+func (s *server) Status(ctx context.Context, in *processpb.StatusRequest) (*processpb.StatusResponse, error) {
+	ids := in.NodeIds
+	if len(ids) == 0 {
+		s.mu.RLock()
+		for id := range s.nodes {
+			ids = append(ids, id)
+		}
+		s.mu.RUnlock()
+		sort.Strings(ids)
+	}
+
+	out := &processpb.StatusResponse{}
+	for _, id := range ids {
+		n, err := s.lookupNode(id)
+		if err != nil {
+			return nil, err
+		}
+		out.Processes = append(out.Processes, n.status())
+	}
+
+	return out, nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"log"
+	"strings"
+	"time"
+)
+
+// route delivers a message written by a node to its destination: another
+// node's stdin for inter-node traffic, or the RPC waiting on it for messages
+// addressed to a client. Messages to a built-in service such as "lin-kv" are
+// answered by the server itself. Traffic is counted in s.netStats.
+func (s *server) route(msg *message, line []byte) {
+	if isClient(msg.Dest) {
+		s.deliverReply(msg, line)
+		return
+	}
+
+	if s.serveKV(msg) {
+		s.netStats.serviceMessage()
+		return
+	}
+
+	if s.node(msg.Dest) != nil {
+		delays := s.faults.plan(msg.Src, msg.Dest)
+		s.netStats.nodeMessage(msg, len(line), len(delays) == 0)
+		for _, d := range delays {
+			s.deliverAfter(msg, d)
+		}
+		return
+	}
+
+	log.Printf("dropping message from %s to unknown destination %q: %s", msg.Src, msg.Dest, line)
+}
+
+// deliverAfter enqueues msg on its destination node once d has passed, on
+// the virtual clock if the cluster is seeded. The node is looked up again at
+// delivery time, so a delayed message goes to whichever process holds that
+// node ID by then.
+func (s *server) deliverAfter(msg *message, d time.Duration) {
+	if sim := s.simulation(); sim != nil {
+		sim.after(msg, d)
+		return
+	}
+
+	if d <= 0 {
+		s.deliverNow(msg)
+		return
+	}
+	time.AfterFunc(d, func() { s.deliverNow(msg) })
+}
+
+// deliverNow enqueues msg on its destination node, if it is still part of
+// the cluster.
+func (s *server) deliverNow(msg *message) {
+	if n := s.node(msg.Dest); n != nil {
+		n.enqueue(msg)
+	}
+}
+
+// deliverReply hands a client-bound message to the RPC whose msg_id it
+// answers.
+func (s *server) deliverReply(msg *message, line []byte) {
+	inReplyTo, ok := msg.bodyInt("in_reply_to")
+	if !ok {
+		log.Printf("unsolicited message from %s: %s", msg.Src, line)
+		return
+	}
+
+	s.pendingMu.Lock()
+	call, ok := s.pending[inReplyTo]
+	delete(s.pending, inReplyTo)
+	s.pendingMu.Unlock()
+
+	if !ok {
+		log.Printf("reply to unknown msg_id %d from %s: %s", inReplyTo, msg.Src, line)
+		return
+	}
+	call.reply <- msg
+}
+
+// node returns the cluster member with the given ID, or nil.
+func (s *server) node(id string) *node {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.nodes[id]
+}
+
+// isClient reports whether id names a Maelstrom client such as "c1".
+func isClient(id string) bool {
+	return strings.HasPrefix(id, "c")
+}
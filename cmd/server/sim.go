@@ -0,0 +1,306 @@
+package main
+
+import (
+	"container/heap"
+	"log"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// simulation is the router of a seeded cluster. Everything the nodes write
+// and every client request goes through its inbox, and a single event loop
+// handles them one step at a time:
+//
+//  1. wait until nothing has arrived for quiet, so that the nodes have
+//     finished reacting to the last delivery;
+//  2. route what arrived, ordered by sender, so that the fault layer draws
+//     from its seed in the same order on every run;
+//  3. deliver one message, picked with the seed from those due at the
+//     current virtual time, or, if none is due, advance the virtual clock to
+//     the next timer.
+//
+// Fault delays are timers on the virtual clock, so they decide the order of
+// delivery without taking real time. Background work of the built-in
+// services, such as lww-kv's gossip, runs in the loop through tick, once
+// every simTickDeliveries deliveries.
+type simulation struct {
+	rng     *rand.Rand
+	quiet   time.Duration
+	route   func(msg *message, line []byte)
+	deliver func(msg *message)
+	tick    func()
+
+	mu    sync.Mutex
+	inbox []simInput
+	wake  chan struct{}
+	stop  chan struct{}
+	done  chan struct{}
+
+	// Owned by the event loop.
+	now       time.Duration
+	ready     []*message
+	timers    simTimers
+	seq       int64
+	delivered int64
+}
+
+// simTickDeliveries is how many messages a simulation delivers between calls
+// to its tick.
+const simTickDeliveries = 10
+
+// simInput is a message waiting in the inbox: one a node wrote, with its
+// line, or a client request, which bypasses the fault layer.
+type simInput struct {
+	msg    *message
+	line   []byte
+	client bool
+}
+
+func newSimulation(seed int64, quiet time.Duration, route func(*message, []byte), deliver func(*message), tick func()) *simulation {
+	sim := &simulation{
+		rng:     rand.New(rand.NewSource(seed)),
+		quiet:   quiet,
+		route:   route,
+		deliver: deliver,
+		tick:    tick,
+		wake:    make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	go sim.run()
+	return sim
+}
+
+// receive queues a message written by a node for routing.
+func (sim *simulation) receive(msg *message, line []byte) {
+	sim.submit(simInput{msg: msg, line: line})
+}
+
+// request queues a client request for delivery to its node.
+func (sim *simulation) request(msg *message) {
+	sim.submit(simInput{msg: msg, client: true})
+}
+
+func (sim *simulation) submit(in simInput) {
+	sim.mu.Lock()
+	sim.inbox = append(sim.inbox, in)
+	sim.mu.Unlock()
+
+	select {
+	case sim.wake <- struct{}{}:
+	default:
+	}
+}
+
+// after schedules msg for delivery once d has passed on the virtual clock.
+// It is only called from the event loop, by route.
+func (sim *simulation) after(msg *message, d time.Duration) {
+	if d <= 0 {
+		sim.ready = append(sim.ready, msg)
+		return
+	}
+	sim.seq++
+	heap.Push(&sim.timers, simTimer{at: sim.now + d, seq: sim.seq, msg: msg})
+}
+
+// close stops the event loop and drops the messages still in flight.
+func (sim *simulation) close() {
+	close(sim.stop)
+	<-sim.done
+}
+
+func (sim *simulation) run() {
+	defer close(sim.done)
+
+	for {
+		if !sim.settle() {
+			break
+		}
+		sim.routeInbox()
+
+		if len(sim.ready) == 0 && !sim.advance() {
+			select {
+			case <-sim.wake:
+				continue
+			case <-sim.stop:
+			}
+			break
+		}
+
+		i := sim.rng.Intn(len(sim.ready))
+		msg := sim.ready[i]
+		last := len(sim.ready) - 1
+		sim.ready[i] = sim.ready[last]
+		sim.ready = sim.ready[:last]
+
+		sim.deliver(msg)
+		sim.delivered++
+		if sim.delivered%simTickDeliveries == 0 {
+			sim.tick()
+		}
+	}
+
+	log.Printf("simulation stopped after delivering %d messages in %s of virtual time", sim.delivered, sim.now)
+}
+
+// settle waits until the inbox has been quiet for sim.quiet, and reports
+// false if the simulation is stopped first.
+func (sim *simulation) settle() bool {
+	for {
+		select {
+		case <-sim.wake:
+		case <-time.After(sim.quiet):
+			return true
+		case <-sim.stop:
+			return false
+		}
+	}
+}
+
+// routeInbox routes everything in the inbox. Inputs are ordered by sender,
+// keeping each node's messages in the order it wrote them; client requests,
+// which may come from concurrent RPCs, are also ordered by destination.
+func (sim *simulation) routeInbox() {
+	sim.mu.Lock()
+	inbox := sim.inbox
+	sim.inbox = nil
+	sim.mu.Unlock()
+
+	sort.SliceStable(inbox, func(i, j int) bool {
+		a, b := inbox[i].msg, inbox[j].msg
+		if a.Src != b.Src {
+			return a.Src < b.Src
+		}
+		return inbox[i].client && a.Dest < b.Dest
+	})
+
+	for _, in := range inbox {
+		if in.client {
+			sim.ready = append(sim.ready, in.msg)
+			continue
+		}
+		sim.route(in.msg, in.line)
+	}
+}
+
+// advance moves the virtual clock to the next timer and makes every message
+// due then ready. It reports false if there are no timers.
+func (sim *simulation) advance() bool {
+	if len(sim.timers) == 0 {
+		return false
+	}
+
+	sim.now = sim.timers[0].at
+	for len(sim.timers) > 0 && sim.timers[0].at == sim.now {
+		t := heap.Pop(&sim.timers).(simTimer)
+		sim.ready = append(sim.ready, t.msg)
+	}
+	return true
+}
+
+// simTimer is a message to deliver at a virtual time. Timers due at the same
+// time are ordered by when they were set.
+type simTimer struct {
+	at  time.Duration
+	seq int64
+	msg *message
+}
+
+// simTimers is a min-heap of timers, for container/heap.
+type simTimers []simTimer
+
+func (h simTimers) Len() int { return len(h) }
+
+func (h simTimers) Less(i, j int) bool {
+	return h[i].at < h[j].at || h[i].at == h[j].at && h[i].seq < h[j].seq
+}
+
+func (h simTimers) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *simTimers) Push(x interface{}) { *h = append(*h, x.(simTimer)) }
+
+func (h *simTimers) Pop() interface{} {
+	old := *h
+	t := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return t
+}
+
+// simulation returns the event loop of the running cluster, or nil if it is
+// not seeded.
+func (s *server) simulation() *simulation {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.sim
+}
+
+// startSimulation seeds the fault layer and key-value services from seed and,
+// if seed is not zero, starts an event loop to route the next cluster's
+// messages. A seed of zero seeds them from the time instead and routes
+// messages as they come. Either way the old key-value services are stopped
+// and msg_ids start again from 1. It must not be called with s.mu held.
+func (s *server) startSimulation(seed int64) {
+	s.mu.Lock()
+	old := s.sim
+	s.sim = nil
+	stopKVServices(s.services)
+	s.services = nil
+	s.kvSeed = 0
+	s.mu.Unlock()
+	if old != nil {
+		old.close()
+	}
+
+	// Messages carry the same msg_ids on every run with the same seed.
+	s.nextMsgID.Store(0)
+
+	if seed == 0 {
+		s.faults.seed(time.Now().UnixNano())
+		return
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+	s.faults.seed(rng.Int63())
+	kvSeed := rng.Int63()
+	sim := newSimulation(rng.Int63(), time.Duration(s.config.SimQuietMs)*time.Millisecond, s.route, s.deliverNow, s.gossipKV)
+
+	s.mu.Lock()
+	s.sim = sim
+	s.kvSeed = kvSeed
+	s.mu.Unlock()
+}
+
+// gossipKV runs one round of the key-value services' gossip, which in a
+// seeded cluster the event loop drives instead of a ticker.
+func (s *server) gossipKV() {
+	s.mu.RLock()
+	services := s.services
+	s.mu.RUnlock()
+
+	gossipKVServices(services)
+}
+
+// forEach calls f for every index below n, concurrently unless the cluster
+// is seeded, in which case its requests must reach the event loop in a
+// fixed order.
+func (s *server) forEach(n int, f func(i int)) {
+	if s.simulation() != nil {
+		for i := 0; i < n; i++ {
+			f(i)
+		}
+		return
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			f(i)
+		}(i)
+	}
+	wg.Wait()
+}
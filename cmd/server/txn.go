@@ -0,0 +1,15 @@
+package main
+
+import (
+	"context"
+
+	txnpb "github.com/Shresth72/go_gRPC_tester/proto/txn"
+)
+
+func (s *server) Txn(ctx context.Context, in *txnpb.TxnRequest) (*txnpb.TxnResponse, error) {
+	out := &txnpb.TxnResponse{}
+	if err := s.call(ctx, in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
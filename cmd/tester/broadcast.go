@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/Shresth72/go_gRPC_tester/history"
+	broadcastpb "github.com/Shresth72/go_gRPC_tester/proto/broadcast"
+)
+
+// broadcastReadEvery is how many broadcasts are sent for every read.
+const broadcastReadEvery = 5
+
+// broadcastSend is a broadcast whose value may be in the cluster: it was
+// acknowledged, or its outcome is unknown.
+type broadcastSend struct {
+	start time.Duration
+	acked bool
+}
+
+// broadcastRead is one successful read and the values it returned.
+type broadcastRead struct {
+	node       string
+	start, end time.Duration
+	final      bool
+	values     map[int32]bool
+}
+
+// runBroadcast sends topology to every node, then broadcasts unique values
+// from concurrent clients to random nodes, reading a random node every few
+// broadcasts. After settling it reads every node once more and classifies
+// each value:
+//
+//   - stable: in every final read, and in every read from some point on
+//   - lost: acknowledged, but missing from a final read
+//   - never-read: not returned by any read
+//   - stale: stable, but missing from a read that started after another read
+//     had already returned it
+//
+// Latencies are measured from the broadcast to the end of the first read
+// after which a stable value was never missed again.
+func runBroadcast(ctx context.Context, broadcastClient broadcastpb.BroadcastServiceClient, nodeIDs []string, topology map[string][]string, settle time.Duration) {
+	for _, id := range nodeIDs {
+		if err := sendTopology(ctx, broadcastClient, 0, id, topology); err != nil {
+			fatalf("Failed to send topology to %s: %v", id, err)
+		}
+	}
+
+	var mu sync.Mutex
+	sends := make(map[int32]*broadcastSend)
+	var reads []broadcastRead
+
+	read := func(process int, dest string, final bool) bool {
+		messages, start, end, err := sendBroadcastRead(ctx, broadcastClient, process, dest)
+		if err != nil {
+			log.Printf("Read from %s by process %d failed: %v", dest, process, err)
+			return false
+		}
+
+		r := broadcastRead{node: dest, start: start, end: end, final: final, values: make(map[int32]bool, len(messages))}
+		for _, v := range messages {
+			r.values[v] = true
+		}
+		mu.Lock()
+		reads = append(reads, r)
+		mu.Unlock()
+		return true
+	}
+
+	count := load.run(load.clients(nodeIDs), func(process, i int) {
+		value := int32(i)
+		start, err := sendBroadcast(ctx, broadcastClient, process, randomNode(nodeIDs), value)
+		switch {
+		case err == nil:
+		case indeterminate(err):
+			log.Printf("Broadcast of %d has an unknown outcome: %v", value, err)
+		default:
+			log.Printf("Broadcast of %d failed: %v", value, err)
+			return
+		}
+
+		mu.Lock()
+		sends[value] = &broadcastSend{start: start, acked: err == nil}
+		mu.Unlock()
+
+		if (i+1)%broadcastReadEvery == 0 {
+			read(process, randomNode(nodeIDs), false)
+		}
+	})
+	log.Printf("Sent %d of %d broadcasts, settling for %s", len(sends), count, settle)
+
+	time.Sleep(settle)
+
+	var failedReads []string
+	for _, id := range nodeIDs {
+		if !read(0, id, true) {
+			failedReads = append(failedReads, id)
+		}
+	}
+	sort.Slice(reads, func(i, j int) bool { return reads[i].start < reads[j].start })
+
+	var stable, lost, neverRead, stale, unexpected []int32
+	var latencies []time.Duration
+	for _, r := range reads {
+		for v := range r.values {
+			if _, ok := sends[v]; !ok && !contains(unexpected, v) {
+				unexpected = append(unexpected, v)
+			}
+		}
+	}
+
+	for value, send := range sends {
+		seen := false
+		inFinal := true
+		var firstSeen time.Duration
+		stableAt := time.Duration(-1)
+		wasStale := false
+
+		for _, r := range reads {
+			if r.values[value] {
+				if !seen {
+					seen, firstSeen = true, r.end
+				}
+				if stableAt < 0 {
+					stableAt = r.end
+				}
+				continue
+			}
+
+			if r.start >= send.start {
+				stableAt = -1
+			}
+			if seen && r.start > firstSeen {
+				wasStale = true
+			}
+			if r.final {
+				inFinal = false
+			}
+		}
+
+		switch {
+		case !seen:
+			neverRead = append(neverRead, value)
+		case inFinal && stableAt >= 0:
+			stable = append(stable, value)
+			latencies = append(latencies, stableAt-send.start)
+			if wasStale {
+				stale = append(stale, value)
+			}
+		}
+		if send.acked && !inFinal {
+			lost = append(lost, value)
+		}
+	}
+
+	for _, vs := range [][]int32{lost, neverRead, stale, unexpected} {
+		sort.Slice(vs, func(i, j int) bool { return vs[i] < vs[j] })
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	log.Printf("broadcast: %d attempted, %d stable, %d lost %v, %d never read %v, %d stale %v",
+		len(sends), len(stable), len(lost), lost, len(neverRead), neverRead, len(stale), stale)
+	if len(latencies) > 0 {
+		log.Printf("broadcast: stable latencies p0 %s, p50 %s, p95 %s, p99 %s, p100 %s",
+			percentile(latencies, 0), percentile(latencies, 0.5), percentile(latencies, 0.95),
+			percentile(latencies, 0.99), percentile(latencies, 1))
+	}
+	if len(unexpected) > 0 {
+		log.Printf("broadcast: reads returned values that were never broadcast: %v", unexpected)
+	}
+
+	var failures []string
+	if len(failedReads) > 0 {
+		failures = append(failures, fmt.Sprintf("final reads of %v failed", failedReads))
+	}
+	if len(unexpected) > 0 {
+		failures = append(failures, fmt.Sprintf("reads returned %d values that were never broadcast", len(unexpected)))
+	}
+	if len(lost) > 0 {
+		failures = append(failures, fmt.Sprintf("%d acknowledged values were lost", len(lost)))
+	}
+	if len(failures) > 0 {
+		fatalf("broadcast: %s", strings.Join(failures, "; "))
+	}
+	log.Printf("broadcast: all %d acknowledged values reached every node", len(stable))
+}
+
+// percentile returns the q-th quantile, 0 <= q <= 1, of sorted durations.
+func percentile(sorted []time.Duration, q float64) time.Duration {
+	i := int(q * float64(len(sorted)-1))
+	return sorted[i]
+}
+
+func contains(vs []int32, v int32) bool {
+	for _, x := range vs {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// sendBroadcast broadcasts value through dest and returns when it was
+// invoked.
+func sendBroadcast(ctx context.Context, broadcastClient broadcastpb.BroadcastServiceClient, process int, dest string, value int32) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	broadcastReq := &broadcastpb.BroadcastRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &broadcastpb.BroadcastRequestBody{
+			Type:    "broadcast",
+			Message: value,
+		},
+	}
+	op := recorder.Invoke(process, "broadcast", value)
+	_, err := broadcastClient.SendBroadcast(ctx, broadcastReq)
+	complete(op, value, err)
+	return op.Time, err
+}
+
+// sendBroadcastRead reads the values dest has seen, along with when the read
+// started and ended.
+func sendBroadcastRead(ctx context.Context, broadcastClient broadcastpb.BroadcastServiceClient, process int, dest string) (messages []int32, start, end time.Duration, err error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	readReq := &broadcastpb.ReadRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &broadcastpb.ReadRequestBody{
+			Type: "read",
+		},
+	}
+	op := recorder.Invoke(process, "read", nil)
+	readRes, err := broadcastClient.SendRead(ctx, readReq)
+	if err != nil {
+		complete(op, nil, err)
+		return nil, 0, 0, err
+	}
+	done := recorder.Complete(op, history.OK, readRes.Body.Messages, nil)
+	return readRes.Body.Messages, op.Time, done.Time, nil
+}
+
+func sendTopology(ctx context.Context, broadcastClient broadcastpb.BroadcastServiceClient, process int, dest string, topology map[string][]string) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	topologyReq := &broadcastpb.TopologyRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &broadcastpb.TopologyRequestBody{
+			Type:     "topology",
+			Topology: topologyBody(topology),
+		},
+	}
+	op := recorder.Invoke(process, "topology", topology)
+	_, err := broadcastClient.SendTopology(ctx, topologyReq)
+	complete(op, topology, err)
+	return err
+}
+
+// topologyBody converts a neighbor map into the list values the topology
+// message carries on the wire.
+func topologyBody(topology map[string][]string) map[string]*structpb.ListValue {
+	body := make(map[string]*structpb.ListValue, len(topology))
+	for id, neighbors := range topology {
+		values := make([]*structpb.Value, len(neighbors))
+		for i, n := range neighbors {
+			values[i] = structpb.NewStringValue(n)
+		}
+		body[id] = &structpb.ListValue{Values: values}
+	}
+	return body
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	counterpb "github.com/Shresth72/go_gRPC_tester/proto/counter"
+	gsetpb "github.com/Shresth72/go_gRPC_tester/proto/gset"
+)
+
+// runGSet adds distinct elements through random nodes, waits for the
+// cluster to settle and then checks that every node reads back every
+// acknowledged element and nothing that was never added.
+func runGSet(ctx context.Context, gsetClient gsetpb.GSetServiceClient, nodeIDs []string, settle time.Duration) {
+	var mu sync.Mutex
+	attempted := make(map[int32]bool)
+	acked := make(map[int32]bool)
+
+	count := load.run(load.clients(nodeIDs), func(process, i int) {
+		element := int32(i)
+		mu.Lock()
+		attempted[element] = true
+		mu.Unlock()
+
+		if err := sendGSetAdd(ctx, gsetClient, process, randomNode(nodeIDs), element); err != nil {
+			log.Printf("Failed to add %d: %v", element, err)
+			return
+		}
+		mu.Lock()
+		acked[element] = true
+		mu.Unlock()
+	})
+	log.Printf("Added %d of %d elements, settling for %s", len(acked), count, settle)
+
+	time.Sleep(settle)
+
+	converged := true
+	for _, id := range nodeIDs {
+		read, err := sendGSetRead(ctx, gsetClient, 0, id)
+		if err != nil {
+			log.Printf("Failed to read from %s: %v", id, err)
+			converged = false
+			continue
+		}
+
+		got := make(map[int32]bool, len(read))
+		var unexpected []int32
+		for _, element := range read {
+			got[element] = true
+			if !attempted[element] {
+				unexpected = append(unexpected, element)
+			}
+		}
+
+		var lost []int32
+		for element := range acked {
+			if !got[element] {
+				lost = append(lost, element)
+			}
+		}
+		sort.Slice(lost, func(i, j int) bool { return lost[i] < lost[j] })
+
+		log.Printf("%s read %d elements: %d lost %v, %d unexpected %v", id, len(read), len(lost), lost, len(unexpected), unexpected)
+		if len(lost) > 0 || len(unexpected) > 0 {
+			converged = false
+		}
+	}
+
+	if !converged {
+		fatalf("g_set did not converge")
+	}
+	log.Printf("g_set converged on %d elements", len(acked))
+}
+
+func sendGSetAdd(ctx context.Context, gsetClient gsetpb.GSetServiceClient, process int, dest string, element int32) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	addReq := &gsetpb.GSetAddRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &gsetpb.GSetAddRequestBody{
+			Type:    "add",
+			Element: element,
+		},
+	}
+	op := recorder.Invoke(process, "add", element)
+	_, err := gsetClient.SendGSetAdd(ctx, addReq)
+	complete(op, element, err)
+	return err
+}
+
+func sendGSetRead(ctx context.Context, gsetClient gsetpb.GSetServiceClient, process int, dest string) ([]int32, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	readReq := &gsetpb.GSetReadRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &gsetpb.GSetReadRequestBody{
+			Type: "read",
+		},
+	}
+	op := recorder.Invoke(process, "read", nil)
+	readRes, err := gsetClient.SendGSetRead(ctx, readReq)
+	complete(op, readRes.GetBody().GetValue(), err)
+	if err != nil {
+		return nil, err
+	}
+	return readRes.Body.Value, nil
+}
+
+// runCounter sends random deltas through random nodes, waits for the
+// cluster to settle and then checks that every node reads the sum of the
+// acknowledged deltas. Deltas whose outcome is unknown widen the accepted
+// range in the direction they would have moved the counter. Only the PN
+// counter receives negative deltas.
+func runCounter(ctx context.Context, counterClient counterpb.CounterServiceClient, nodeIDs []string, settle time.Duration, pn bool) {
+	name := "g_counter"
+	if pn {
+		name = "pn_counter"
+	}
+
+	var mu sync.Mutex
+	var sum, low, high int64
+	load.run(load.clients(nodeIDs), func(process, i int) {
+		delta := int32(rng.Intn(5) + 1)
+		if pn && rng.Intn(2) == 0 {
+			delta = -delta
+		}
+
+		err := sendCounterAdd(ctx, counterClient, process, randomNode(nodeIDs), delta)
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case err == nil:
+			sum += int64(delta)
+		case indeterminate(err):
+			log.Printf("Add of %d has an unknown outcome: %v", delta, err)
+			if delta < 0 {
+				low += int64(delta)
+			} else {
+				high += int64(delta)
+			}
+		default:
+			log.Printf("Add of %d failed: %v", delta, err)
+		}
+	})
+	low += sum
+	high += sum
+	log.Printf("Acknowledged total is %d (accepting %d..%d), settling for %s", sum, low, high, settle)
+
+	time.Sleep(settle)
+
+	converged := true
+	values := make(map[int32]bool)
+	for _, id := range nodeIDs {
+		value, err := sendCounterRead(ctx, counterClient, 0, id)
+		if err != nil {
+			log.Printf("Failed to read from %s: %v", id, err)
+			converged = false
+			continue
+		}
+
+		log.Printf("%s read %d", id, value)
+		values[value] = true
+		if int64(value) < low || int64(value) > high {
+			converged = false
+		}
+	}
+	if len(values) > 1 {
+		log.Printf("Nodes disagree on the counter value")
+		converged = false
+	}
+
+	if !converged {
+		fatalf("%s did not converge", name)
+	}
+	log.Printf("%s converged", name)
+}
+
+func sendCounterAdd(ctx context.Context, counterClient counterpb.CounterServiceClient, process int, dest string, delta int32) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	addReq := &counterpb.CounterAddRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &counterpb.CounterAddRequestBody{
+			Type:  "add",
+			Delta: delta,
+		},
+	}
+	op := recorder.Invoke(process, "add", delta)
+	_, err := counterClient.SendCounterAdd(ctx, addReq)
+	complete(op, delta, err)
+	return err
+}
+
+func sendCounterRead(ctx context.Context, counterClient counterpb.CounterServiceClient, process int, dest string) (int32, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	readReq := &counterpb.CounterReadRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &counterpb.CounterReadRequestBody{
+			Type: "read",
+		},
+	}
+	op := recorder.Invoke(process, "read", nil)
+	readRes, err := counterClient.SendCounterRead(ctx, readReq)
+	complete(op, readRes.GetBody().GetValue(), err)
+	if err != nil {
+		return 0, err
+	}
+	return readRes.Body.Value, nil
+}
+
+func randomNode(nodeIDs []string) string {
+	return nodeIDs[rng.Intn(len(nodeIDs))]
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	echopb "github.com/Shresth72/go_gRPC_tester/proto/echo"
+)
+
+// echoKinds are the kinds of payload the echo workload cycles through.
+var echoKinds = []string{"ascii", "empty", "unicode", "escapes", "json", "large"}
+
+// echoRunes and echoEscapes are the alphabets of the unicode and escapes
+// payloads: multi-byte and astral characters, combining marks, joiners and
+// direction marks, and characters JSON encoders escape or treat specially.
+var (
+	echoRunes   = []string{"é", "ß", "Ω", "中", "文", "م", "😀", "🚀", "👩\u200d💻", "e\u0301", "\u200f", "\ufeff"}
+	echoEscapes = []string{`"`, `\`, `/`, "\n", "\r", "\t", "\b", "\f", "\x00", "\x1f", "\u2028", "\u2029", "<", ">", "&", "'"}
+)
+
+// echoFailure is a reply that did not match its request.
+type echoFailure struct {
+	kind    string
+	problem string
+	dest    string
+	payload string
+}
+
+// runEcho sends echo requests from concurrent clients to random nodes, with
+// unique msg_ids and payloads that cycle through echoKinds. Every reply must
+// be an echo_ok from the node asked, carry the payload back unchanged, answer
+// the request's msg_id in in_reply_to and have a msg_id of its own that the
+// node has not used before.
+func runEcho(ctx context.Context, echoClient echopb.EchoServiceClient, nodeIDs []string) {
+	var nextMsgID atomic.Int32
+	var mu sync.Mutex
+	var failures []echoFailure
+	replyIDs := make(map[string]map[int32]bool)
+	sent := make(map[string]int)
+
+	count := load.run(load.clients(nodeIDs), func(process, i int) {
+		kind, payload := echoPayload(i)
+		dest := randomNode(nodeIDs)
+		msgID := nextMsgID.Add(1)
+
+		var problems []string
+		res, err := sendEchoRequest(ctx, echoClient, process, dest, msgID, payload)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("request failed: %v", err))
+		} else {
+			if res.Src != dest {
+				problems = append(problems, fmt.Sprintf("reply came from %q", res.Src))
+			}
+			if res.Body.Type != "echo_ok" {
+				problems = append(problems, fmt.Sprintf("reply type is %q", res.Body.Type))
+			}
+			if res.Body.Echo != payload {
+				problems = append(problems, fmt.Sprintf("echo is %s", abbreviate(res.Body.Echo)))
+			}
+			if res.Body.InReplyTo != msgID {
+				problems = append(problems, fmt.Sprintf("in_reply_to is %d, not %d", res.Body.InReplyTo, msgID))
+			}
+		}
+
+		mu.Lock()
+		sent[kind]++
+		if err == nil && res.Body.MsgId != 0 {
+			if replyIDs[dest] == nil {
+				replyIDs[dest] = make(map[int32]bool)
+			}
+			if replyIDs[dest][res.Body.MsgId] {
+				problems = append(problems, fmt.Sprintf("reply msg_id %d was already used by %s", res.Body.MsgId, dest))
+			}
+			replyIDs[dest][res.Body.MsgId] = true
+		}
+		for _, problem := range problems {
+			failures = append(failures, echoFailure{kind: kind, problem: problem, dest: dest, payload: payload})
+		}
+		mu.Unlock()
+	})
+
+	failed := make(map[string]int)
+	for _, f := range failures {
+		failed[f.kind]++
+	}
+
+	kinds := make([]string, 0, len(sent))
+	for kind := range sent {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	log.Printf("echo: %d requests, %d failures", count, len(failures))
+	for _, kind := range kinds {
+		log.Printf("echo: %-8s %5d sent %5d failed", kind, sent[kind], failed[kind])
+	}
+
+	if len(failures) > 0 {
+		for i, f := range failures {
+			if i == echoReportLimit {
+				log.Printf("echo: ... and %d more", len(failures)-i)
+				break
+			}
+			log.Printf("echo: %s payload %s to %s: %s", f.kind, abbreviate(f.payload), f.dest, f.problem)
+		}
+		fatalf("echo found %d failures", len(failures))
+	}
+	log.Printf("echo: every reply matched its request")
+}
+
+// echoReportLimit is how many failures runEcho prints in full.
+const echoReportLimit = 10
+
+// echoPayload returns the i-th payload and its kind.
+func echoPayload(i int) (kind string, payload string) {
+	kind = echoKinds[i%len(echoKinds)]
+
+	var b strings.Builder
+	switch kind {
+	case "ascii":
+		for n := rng.Intn(64) + 1; n > 0; n-- {
+			b.WriteByte(byte(' ' + rng.Intn('~'-' '+1)))
+		}
+	case "unicode":
+		for n := rng.Intn(64) + 1; n > 0; n-- {
+			b.WriteString(echoRunes[rng.Intn(len(echoRunes))])
+		}
+	case "escapes":
+		for n := rng.Intn(64) + 1; n > 0; n-- {
+			b.WriteString(echoEscapes[rng.Intn(len(echoEscapes))])
+		}
+	case "json":
+		fmt.Fprintf(&b, `{"type":"echo_ok","in_reply_to":%d,"echo":"%d"}`, rng.Intn(100), i)
+	case "large":
+		for n := 1<<16 + rng.Intn(3<<16); b.Len() < n; {
+			if rng.Intn(8) == 0 {
+				b.WriteString(echoRunes[rng.Intn(len(echoRunes))])
+			} else {
+				b.WriteByte(byte('a' + rng.Intn(26)))
+			}
+		}
+	}
+	return kind, b.String()
+}
+
+// abbreviate quotes s, shortening it for the report if it is long.
+func abbreviate(s string) string {
+	const limit = 40
+	if r := []rune(s); len(r) > limit {
+		return fmt.Sprintf("%q... (%d bytes)", string(r[:limit]), len(s))
+	}
+	return fmt.Sprintf("%q", s)
+}
+
+func sendEchoRequest(ctx context.Context, echoClient echopb.EchoServiceClient, process int, dest string, msgID int32, echo string) (*echopb.EchoResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	echoReq := &echopb.EchoRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &echopb.EchoRequestBody{
+			Type:  "echo",
+			MsgId: msgID,
+			Echo:  echo,
+		},
+	}
+
+	op := recorder.Invoke(process, "echo", echo)
+	echoRes, err := echoClient.SendEcho(ctx, echoReq)
+	complete(op, echoRes.GetBody().GetEcho(), err)
+	return echoRes, err
+}
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/Shresth72/go_gRPC_tester/history"
+	kafkapb "github.com/Shresth72/go_gRPC_tester/proto/kafka"
+)
+
+// kafkaCommitEvery is how many acknowledged sends a producer makes between
+// offset commits.
+const kafkaCommitEvery = 10
+
+// kafkaSend is an acknowledged send: msg was stored at offset in key's log.
+type kafkaSend struct {
+	key    string
+	msg    int32
+	offset int32
+}
+
+// runKafka produces messages from one concurrent producer per key, so -keys
+// rather than -concurrency sets its number of clients. Each producer commits
+// its latest offset as it goes. After settling it polls every log from every
+// node and checks for lost writes, non-monotonic offsets and committed
+// offsets that went backwards or point past the log.
+func runKafka(ctx context.Context, kafkaClient kafkapb.KafkaServiceClient, nodeIDs []string, keys int, settle time.Duration) {
+	var mu sync.Mutex
+	var acked []kafkaSend
+	var problems []string
+	committed := make(map[string]int32)
+
+	problem := func(format string, args ...interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	// Each producer's state is only touched by its own process.
+	last := make([]int32, keys)
+	sent := make([]int, keys)
+	for k := range last {
+		last[k] = -1
+	}
+
+	count := load.run(keys, func(k, i int) {
+		key := fmt.Sprintf("k%d", k)
+		msg := int32(i)
+		offset, err := sendKafkaSend(ctx, kafkaClient, k, randomNode(nodeIDs), key, msg)
+		if err != nil {
+			log.Printf("Failed to send %d to %s: %v", msg, key, err)
+			return
+		}
+
+		if offset <= last[k] {
+			problem("%s: send of %d got offset %d after offset %d", key, msg, offset, last[k])
+		}
+		last[k] = offset
+		sent[k]++
+
+		mu.Lock()
+		acked = append(acked, kafkaSend{key: key, msg: msg, offset: offset})
+		mu.Unlock()
+
+		if sent[k]%kafkaCommitEvery != 0 {
+			return
+		}
+		if err := sendCommitOffsets(ctx, kafkaClient, k, randomNode(nodeIDs), map[string]int32{key: last[k]}); err != nil {
+			log.Printf("Failed to commit %s at %d: %v", key, last[k], err)
+			return
+		}
+		mu.Lock()
+		committed[key] = last[k]
+		mu.Unlock()
+	})
+	log.Printf("Acknowledged %d of %d sends, settling for %s", len(acked), count, settle)
+
+	time.Sleep(settle)
+
+	keyNames := make([]string, keys)
+	byKey := make(map[string][]kafkaSend)
+	for k := range keyNames {
+		keyNames[k] = fmt.Sprintf("k%d", k)
+	}
+	for _, send := range acked {
+		byKey[send.key] = append(byKey[send.key], send)
+	}
+
+	for _, key := range keyNames {
+		seen := make(map[int32]int32)
+		for _, send := range byKey[key] {
+			if msg, ok := seen[send.offset]; ok {
+				problem("%s: messages %d and %d were both acknowledged at offset %d", key, msg, send.msg, send.offset)
+			}
+			seen[send.offset] = send.msg
+		}
+	}
+
+	var reference map[string]map[int32]int32
+	var referenceID string
+	for _, id := range nodeIDs {
+		logs, err := pollAll(ctx, kafkaClient, 0, id, keyNames)
+		if err != nil {
+			problem("%s: poll failed: %v", id, err)
+			continue
+		}
+
+		lost := 0
+		for _, send := range acked {
+			msg, ok := logs[send.key][send.offset]
+			switch {
+			case !ok:
+				lost++
+			case msg != send.msg:
+				problem("%s: %s offset %d holds %d, but %d was acknowledged there", id, send.key, send.offset, msg, send.msg)
+			}
+		}
+		if lost > 0 {
+			problem("%s: %d acknowledged sends are missing from the log", id, lost)
+		}
+
+		if reference == nil {
+			reference, referenceID = logs, id
+		} else {
+			for _, key := range keyNames {
+				for offset, msg := range logs[key] {
+					if other, ok := reference[key][offset]; ok && other != msg {
+						problem("%s: %s offset %d holds %d, but %s has %d", id, key, offset, msg, referenceID, other)
+					}
+				}
+			}
+		}
+
+		offsets, err := sendListCommittedOffsets(ctx, kafkaClient, 0, id, keyNames)
+		if err != nil {
+			problem("%s: list_committed_offsets failed: %v", id, err)
+			continue
+		}
+		for _, key := range keyNames {
+			want, ok := committed[key]
+			if !ok {
+				continue
+			}
+			got, ok := offsets[key]
+			if !ok || got < want {
+				problem("%s: committed offset for %s is %d (present: %t), but %d was committed", id, key, got, ok, want)
+			}
+			if _, ok := logs[key][got]; got > want && !ok {
+				problem("%s: committed offset %d for %s is not in the log", id, got, key)
+			}
+		}
+
+		log.Printf("%s: polled %d keys, %d lost", id, len(logs), lost)
+	}
+
+	if len(problems) > 0 {
+		for _, p := range problems {
+			log.Printf("kafka: %s", p)
+		}
+		fatalf("kafka found %d problems", len(problems))
+	}
+	log.Printf("kafka: all %d acknowledged sends are present and consistent", len(acked))
+}
+
+// pollAll reads the whole log of every key from dest, checking that each poll
+// returns strictly increasing offsets.
+func pollAll(ctx context.Context, kafkaClient kafkapb.KafkaServiceClient, process int, dest string, keys []string) (map[string]map[int32]int32, error) {
+	logs := make(map[string]map[int32]int32, len(keys))
+	offsets := make(map[string]int32, len(keys))
+	for _, key := range keys {
+		logs[key] = make(map[int32]int32)
+		offsets[key] = 0
+	}
+
+	for len(offsets) > 0 {
+		msgs, err := sendPoll(ctx, kafkaClient, process, dest, offsets)
+		if err != nil {
+			return nil, err
+		}
+
+		next := make(map[string]int32)
+		for key, from := range offsets {
+			entries := msgs[key]
+
+			last := from - 1
+			for _, e := range entries {
+				if e[0] <= last {
+					return nil, fmt.Errorf("poll of %s returned offset %d after %d", key, e[0], last)
+				}
+				last = e[0]
+				logs[key][e[0]] = e[1]
+			}
+			if len(entries) > 0 {
+				next[key] = last + 1
+			}
+		}
+		offsets = next
+	}
+
+	return logs, nil
+}
+
+func sendKafkaSend(ctx context.Context, kafkaClient kafkapb.KafkaServiceClient, process int, dest string, key string, msg int32) (int32, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	sendReq := &kafkapb.SendRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &kafkapb.SendRequestBody{
+			Type: "send",
+			Key:  key,
+			Msg:  msg,
+		},
+	}
+	op := recorder.Invoke(process, "send", map[string]interface{}{"key": key, "msg": msg})
+	sendRes, err := kafkaClient.Send(ctx, sendReq)
+	complete(op, map[string]interface{}{"key": key, "msg": msg, "offset": sendRes.GetBody().GetOffset()}, err)
+	if err != nil {
+		return 0, err
+	}
+	return sendRes.Body.Offset, nil
+}
+
+// sendPoll returns each key's [offset, msg] pairs in the order the node sent
+// them.
+func sendPoll(ctx context.Context, kafkaClient kafkapb.KafkaServiceClient, process int, dest string, offsets map[string]int32) (map[string][][2]int32, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	pollReq := &kafkapb.PollRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &kafkapb.PollRequestBody{
+			Type:    "poll",
+			Offsets: offsets,
+		},
+	}
+	op := recorder.Invoke(process, "poll", offsets)
+	pollRes, err := kafkaClient.Poll(ctx, pollReq)
+	if err != nil {
+		complete(op, nil, err)
+		return nil, err
+	}
+
+	msgs := make(map[string][][2]int32, len(pollRes.Body.Msgs))
+	for key, list := range pollRes.Body.Msgs {
+		for _, v := range list.Values {
+			pair := v.GetListValue().GetValues()
+			if len(pair) != 2 {
+				err := fmt.Errorf("poll of %s returned %v, not an [offset, msg] pair", key, v)
+				recorder.Complete(op, history.Info, op.Value, err)
+				return nil, err
+			}
+			msgs[key] = append(msgs[key], [2]int32{int32(pair[0].GetNumberValue()), int32(pair[1].GetNumberValue())})
+		}
+	}
+	complete(op, msgs, nil)
+	return msgs, nil
+}
+
+func sendCommitOffsets(ctx context.Context, kafkaClient kafkapb.KafkaServiceClient, process int, dest string, offsets map[string]int32) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	commitReq := &kafkapb.CommitOffsetsRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &kafkapb.CommitOffsetsRequestBody{
+			Type:    "commit_offsets",
+			Offsets: offsets,
+		},
+	}
+	op := recorder.Invoke(process, "commit_offsets", offsets)
+	_, err := kafkaClient.CommitOffsets(ctx, commitReq)
+	complete(op, offsets, err)
+	return err
+}
+
+func sendListCommittedOffsets(ctx context.Context, kafkaClient kafkapb.KafkaServiceClient, process int, dest string, keys []string) (map[string]int32, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	listReq := &kafkapb.ListCommittedOffsetsRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &kafkapb.ListCommittedOffsetsRequestBody{
+			Type: "list_committed_offsets",
+			Keys: keys,
+		},
+	}
+	op := recorder.Invoke(process, "list_committed_offsets", keys)
+	listRes, err := kafkaClient.ListCommittedOffsets(ctx, listReq)
+	complete(op, listRes.GetBody().GetOffsets(), err)
+	if err != nil {
+		return nil, err
+	}
+	return listRes.Body.Offsets, nil
+}
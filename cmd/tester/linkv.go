@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Shresth72/go_gRPC_tester/checker/linearizable"
+	"github.com/Shresth72/go_gRPC_tester/history"
+	kvpb "github.com/Shresth72/go_gRPC_tester/proto/kv"
+)
+
+// linKVValues is the number of distinct values the lin_kv workload writes,
+// kept small so that cas operations often succeed.
+const linKVValues = 5
+
+// runLinKV sends random reads, writes and cas operations over keys from
+// concurrent clients to random nodes, then checks that the recorded history
+// of every lin_kv workload so far is linearizable.
+func runLinKV(ctx context.Context, kvClient kvpb.KVServiceClient, nodeIDs []string, keys int) {
+	load.run(load.clients(nodeIDs), func(process, i int) {
+		key := int32(rng.Intn(keys))
+		dest := randomNode(nodeIDs)
+
+		var err error
+		switch rng.Intn(3) {
+		case 0:
+			_, _, err = sendKVRead(ctx, kvClient, process, dest, key)
+		case 1:
+			err = sendKVWrite(ctx, kvClient, process, dest, key, int32(rng.Intn(linKVValues)))
+		default:
+			err = sendKVCas(ctx, kvClient, process, dest, key, int32(rng.Intn(linKVValues)), int32(rng.Intn(linKVValues)))
+		}
+		if err != nil {
+			log.Printf("Operation on key %d by process %d failed: %v", key, process, err)
+		}
+	})
+
+	operations := linearizable.Operations(phaseOps(LinKVRequest.String()))
+	result := linearizable.CheckOperations(linearizable.KV, operations)
+	if result.Unknown {
+		fatalf("lin_kv could not decide whether key %s is linearizable", result.Partition)
+	}
+	if !result.Valid {
+		log.Printf("Key %s is not linearizable; minimal window of %d operations:", result.Partition, len(result.Window))
+		for _, op := range result.Window {
+			log.Printf("  %s", op)
+		}
+		fatalf("lin_kv history is not linearizable")
+	}
+	log.Printf("lin_kv history of %d operations is linearizable", len(operations))
+}
+
+// sendKVRead reads key from dest. A key that does not exist yet is recorded as
+// a successful read of null and reported with found false.
+func sendKVRead(ctx context.Context, kvClient kvpb.KVServiceClient, process int, dest string, key int32) (value int32, found bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	readReq := &kvpb.KVReadRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &kvpb.KVReadRequestBody{
+			Type: "read",
+			Key:  key,
+		},
+	}
+	op := recorder.Invoke(process, "read", []interface{}{key, nil})
+	readRes, err := kvClient.SendKVRead(ctx, readReq)
+	if status.Code(err) == codes.NotFound {
+		recorder.Complete(op, history.OK, []interface{}{key, nil}, nil)
+		return 0, false, nil
+	}
+	complete(op, []interface{}{key, readRes.GetBody().GetValue()}, err)
+	if err != nil {
+		return 0, false, err
+	}
+	return readRes.Body.Value, true, nil
+}
+
+func sendKVWrite(ctx context.Context, kvClient kvpb.KVServiceClient, process int, dest string, key int32, value int32) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	writeReq := &kvpb.KVWriteRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &kvpb.KVWriteRequestBody{
+			Type:  "write",
+			Key:   key,
+			Value: value,
+		},
+	}
+	op := recorder.Invoke(process, "write", []interface{}{key, value})
+	_, err := kvClient.SendKVWrite(ctx, writeReq)
+	complete(op, []interface{}{key, value}, err)
+	return err
+}
+
+func sendKVCas(ctx context.Context, kvClient kvpb.KVServiceClient, process int, dest string, key int32, from int32, to int32) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	casReq := &kvpb.KVCasRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &kvpb.KVCasRequestBody{
+			Type: "cas",
+			Key:  key,
+			From: from,
+			To:   to,
+		},
+	}
+	value := []interface{}{key, []interface{}{from, to}}
+	op := recorder.Invoke(process, "cas", value)
+	_, err := kvClient.SendKVCas(ctx, casReq)
+	complete(op, value, err)
+	return err
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// loadSpec controls how many operations a workload issues, from how many
+// logical clients and how fast. It is set by -count, -concurrency, -rate,
+// -arrival and -duration, or by a scenario's workload.
+type loadSpec struct {
+	// count stops the workload after that many operations; 0 means no limit.
+	count int
+	// concurrency is the number of logical clients; 0 means one per node.
+	concurrency int
+	// rate is the target number of operations per second across all
+	// clients. 0 runs closed-loop: each client issues its next operation as
+	// soon as the previous one completes.
+	rate float64
+	// arrival is the open-loop schedule: "bucket" spaces operations evenly,
+	// as a token bucket does, and "poisson" draws exponential gaps.
+	arrival string
+	// duration stops the workload after that long; 0 means no limit.
+	duration time.Duration
+}
+
+// load is the load of the workload being run.
+var load = loadSpec{arrival: "bucket"}
+
+func (l loadSpec) validate() error {
+	switch {
+	case l.count < 0:
+		return fmt.Errorf("count cannot be negative: %d", l.count)
+	case l.count == 0 && l.duration <= 0:
+		return fmt.Errorf("need a -count or a -duration")
+	case l.concurrency < 0:
+		return fmt.Errorf("concurrency cannot be negative: %d", l.concurrency)
+	case l.rate < 0:
+		return fmt.Errorf("rate cannot be negative: %g", l.rate)
+	case l.arrival != "bucket" && l.arrival != "poisson":
+		return fmt.Errorf("unknown arrival schedule %q, want bucket or poisson", l.arrival)
+	}
+	return nil
+}
+
+// clients returns the number of logical clients to run against nodeIDs.
+func (l loadSpec) clients(nodeIDs []string) int {
+	if l.concurrency > 0 {
+		return l.concurrency
+	}
+	return len(nodeIDs)
+}
+
+// run issues operations to a pool of processes workers, numbering them from
+// 0, until the count or duration is used up, and returns how many it issued.
+// op(process, i) performs operation i as logical client process; a process
+// runs one operation at a time. With a rate, operations are handed out on an
+// open-loop schedule, and wait for a free client when every client is busy.
+// Node faults are injected while operations are issued, and the cluster is
+// recovered before run returns.
+func (l loadSpec) run(processes int, op func(process, i int)) int {
+	if seed != 0 {
+		return l.runSeeded(processes, op)
+	}
+
+	stopNodeFaults := startNodeFaults()
+
+	var deadline <-chan time.Time
+	if l.duration > 0 {
+		timer := time.NewTimer(l.duration)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	ops := make(chan int)
+	var wg sync.WaitGroup
+	for p := 0; p < processes; p++ {
+		wg.Add(1)
+		go func(process int) {
+			defer wg.Done()
+			for i := range ops {
+				op(process, i)
+			}
+		}(p)
+	}
+
+	start := time.Now()
+	next := start
+	issued := 0
+	late := 0
+
+dispatch:
+	for ; l.count == 0 || issued < l.count; issued++ {
+		if l.rate > 0 {
+			next = l.nextArrival(next, processes)
+			wait := time.NewTimer(time.Until(next))
+			select {
+			case <-wait.C:
+			case <-deadline:
+				wait.Stop()
+				break dispatch
+			}
+		}
+
+		select {
+		case ops <- issued:
+		case <-deadline:
+			break dispatch
+		}
+		if l.rate > 0 && time.Since(next) > time.Duration(float64(time.Second)/l.rate) {
+			late++
+		}
+	}
+	close(ops)
+	wg.Wait()
+	elapsed := time.Since(start)
+	stopNodeFaults()
+
+	log.Printf("Issued %d operations from %d clients in %s (%.1f/s)", issued, processes, elapsed.Round(time.Millisecond), float64(issued)/elapsed.Seconds())
+	if late > 0 {
+		log.Printf("%d operations started late because every client was busy", late)
+	}
+	return issued
+}
+
+// runSeeded is run for a seeded run. Operations are issued one at a time,
+// each from a client drawn from rng, and the count, rate and duration are
+// measured on the virtual clock: an operation starts when the open-loop
+// schedule reaches it, or closedLoopStep after the last one without a rate,
+// however long the operations really take.
+func (l loadSpec) runSeeded(processes int, op func(process, i int)) int {
+	stopNodeFaults := startNodeFaults()
+
+	realStart := time.Now()
+	start := virtual.Now()
+	at := start
+	issued := 0
+	for ; l.count == 0 || issued < l.count; issued++ {
+		if l.rate > 0 {
+			at += l.gap()
+		} else {
+			at += closedLoopStep
+		}
+		if l.duration > 0 && at-start > l.duration {
+			break
+		}
+
+		virtual.advance(at)
+		op(rng.Intn(processes), issued)
+	}
+	elapsed := time.Since(realStart)
+	stopNodeFaults()
+
+	log.Printf("Issued %d operations from %d clients in %s of virtual time (%s real)",
+		issued, processes, (at - start).Round(time.Millisecond), elapsed.Round(time.Millisecond))
+	return issued
+}
+
+// nextArrival returns when the operation after one scheduled at prev should
+// start. A schedule that has fallen behind may catch up with a burst of at
+// most one operation per client.
+func (l loadSpec) nextArrival(prev time.Time, processes int) time.Time {
+	mean := time.Duration(float64(time.Second) / l.rate)
+
+	next := prev.Add(l.gap())
+	if earliest := time.Now().Add(-time.Duration(processes) * mean); next.Before(earliest) {
+		next = earliest
+	}
+	return next
+}
+
+// gap draws the time from one operation to the next on the open-loop
+// schedule.
+func (l loadSpec) gap() time.Duration {
+	mean := time.Duration(float64(time.Second) / l.rate)
+	if l.arrival == "poisson" {
+		return time.Duration(rng.ExpFloat64() * float64(mean))
+	}
+	return mean
+}
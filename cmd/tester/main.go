@@ -1,29 +1,66 @@
 package main
 
-// go build -o bin/tester cmd/tester/main.go && ./bin/tester
+// go build -o bin/tester ./cmd/tester && ./bin/tester -request echo -count 10 [-- node args...]
 
 import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"log"
+	"os"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
+	"github.com/Shresth72/go_gRPC_tester/history"
 	broadcastpb "github.com/Shresth72/go_gRPC_tester/proto/broadcast"
+	counterpb "github.com/Shresth72/go_gRPC_tester/proto/counter"
 	echopb "github.com/Shresth72/go_gRPC_tester/proto/echo"
+	gsetpb "github.com/Shresth72/go_gRPC_tester/proto/gset"
 	initpb "github.com/Shresth72/go_gRPC_tester/proto/init"
+	kafkapb "github.com/Shresth72/go_gRPC_tester/proto/kafka"
+	kvpb "github.com/Shresth72/go_gRPC_tester/proto/kv"
+	maelstrompb "github.com/Shresth72/go_gRPC_tester/proto/maelstrom"
+	nemesispb "github.com/Shresth72/go_gRPC_tester/proto/nemesis"
+	processpb "github.com/Shresth72/go_gRPC_tester/proto/process"
+	statspb "github.com/Shresth72/go_gRPC_tester/proto/stats"
+	txnpb "github.com/Shresth72/go_gRPC_tester/proto/txn"
 	uniqueidpb "github.com/Shresth72/go_gRPC_tester/proto/unique_ids"
 )
 
+// clientID is the Maelstrom client name the tester sends requests as.
+const clientID = "c1"
+
+// requestTimeout bounds each RPC the tester makes; set by -timeout or the
+// scenario.
+var requestTimeout = time.Second
+
+// recorder holds every client operation of the run. It is written out by
+// writeHistory when -history is set.
+var recorder = history.NewRecorder()
+
+// historyPath is the -history file name, without extension.
+var historyPath string
+
 type RequestType int
 
 const (
 	EchoRequest RequestType = iota
 	UniqueIdsRequest
 	BroadcastRequest
+	MessageRequest
+	GSetRequest
+	GCounterRequest
+	PNCounterRequest
+	KafkaRequest
+	TxnRWRegisterRequest
+	TxnListAppendRequest
+	LinKVRequest
 	UnknownRequest
 )
 
@@ -35,164 +72,328 @@ func (rt RequestType) String() string {
 		return "unique_ids"
 	case BroadcastRequest:
 		return "broadcast"
+	case MessageRequest:
+		return "message"
+	case GSetRequest:
+		return "g_set"
+	case GCounterRequest:
+		return "g_counter"
+	case PNCounterRequest:
+		return "pn_counter"
+	case KafkaRequest:
+		return "kafka"
+	case TxnRWRegisterRequest:
+		return "txn_rw_register"
+	case TxnListAppendRequest:
+		return "txn_list_append"
+	case LinKVRequest:
+		return "lin_kv"
 	default:
 		return "unknown"
 	}
 }
 
 func main() {
-	var requestTypeStr string
-	var requestCount int
+	var scenarioPath string
+	var request, body string
+	var rate float64
+	cli := defaultScenario()
 
-	flag.StringVar(&requestTypeStr, "request", "", "type of request")
-	flag.IntVar(&requestCount, "count", 0, "number of requests")
+	flag.StringVar(&scenarioPath, "scenario", "", "run the JSON test plan in `path`; flags set on the command line override its settings")
+	flag.StringVar(&request, "request", "", "type of request")
+	flag.IntVar(&cli.Count, "count", 0, "number of requests (0 runs until -duration)")
+	flag.IntVar(&cli.Concurrency, "concurrency", 0, "number of concurrent logical clients (default one per node)")
+	flag.Float64Var(&rate, "rate", 0, "target requests per second across all clients, open-loop (0 runs closed-loop)")
+	flag.StringVar(&cli.Arrival, "arrival", cli.Arrival, "open-loop arrival schedule for -rate: bucket or poisson")
+	flag.DurationVar((*time.Duration)(&cli.Duration), "duration", 0, "stop issuing requests after this long (0 runs until -count)")
+	flag.IntVar(&cli.Nodes, "nodes", cli.Nodes, "number of nodes in the cluster")
+	flag.StringVar(&cli.Topology.shape, "topology", cli.Topology.shape, "broadcast topology: "+strings.Join(topologyShapes, ", "))
+	flag.IntVar(&cli.Branching, "branching", cli.Branching, "number of children of each node in a tree topology")
+	flag.IntVar(&cli.Degree, "degree", cli.Degree, "number of neighbors of each node in a regular topology")
+	flag.StringVar(&cli.Binary, "binary", "", "node binary, absolute or relative to the server's -bin-dir (defaults to the request type)")
+	flag.StringVar(&body, "body", "", "JSON message body for -request message, e.g. '{\"type\":\"read\"}'")
+	flag.DurationVar((*time.Duration)(&cli.Timeout), "timeout", time.Duration(cli.Timeout), "timeout for each request")
+	flag.DurationVar((*time.Duration)(&cli.Settle), "settle", time.Duration(cli.Settle), "quiescence period before the final reads of the broadcast, CRDT and kafka workloads")
+	flag.IntVar(&cli.Keys, "keys", cli.Keys, "number of keys used by the kafka, txn and lin_kv workloads")
+	flag.DurationVar((*time.Duration)(&cli.ClockSkew), "clock-skew", 0, "give each node a random clock offset of up to this much either way")
+	flag.Float64Var(&cli.ClockDrift, "clock-drift", 0, "give each node a random clock drift of up to this many ppm either way")
+	flag.Func("node-faults", "comma-separated node faults to inject at random while requests are issued: kill, pause", func(v string) error {
+		cli.NodeFaults.Faults = strings.Split(v, ",")
+		return nil
+	})
+	flag.DurationVar((*time.Duration)(&cli.NodeFaults.Interval), "node-fault-interval", time.Duration(cli.NodeFaults.Interval), "time between faulting a node and recovering it, and between recovering it and the next fault")
+	flag.StringVar(&cli.History, "history", "", "write the operation history to `path`.jsonl and path.edn")
+	flag.Int64Var(&cli.Seed, "seed", 0, "make the run deterministic, with the server routing messages as a simulation, so that the same seed replays it (0 for a normal run)")
+	flag.StringVar(&cli.Report, "report", "", "write per-RPC latency, error and throughput statistics to `path`, as CSV if it ends in .csv and JSON otherwise")
 	flag.Parse()
 
-	requestType, err := parseRequestType(requestTypeStr)
-	if err != nil {
-		log.Fatalf("Invalid request type: %v", err)
+	if request != "" {
+		cli.Workloads = []workload{{Request: request, Body: body}}
 	}
+	cli.Args = flag.Args()
+	cli.Rate = &rate
 
-	if requestCount <= 0 {
-		log.Fatalf("count cannot be less or equal to 0: %d", requestCount)
+	sc := cli
+	if scenarioPath != "" {
+		var err error
+		if sc, err = loadScenario(scenarioPath, cli); err != nil {
+			log.Fatalf("Invalid scenario: %v", err)
+		}
+	}
+	if err := sc.validate(); err != nil {
+		log.Fatalf("Invalid scenario: %v", err)
+	}
+	requestTimeout = time.Duration(sc.Timeout)
+	historyPath = sc.History
+	reportPath = sc.Report
+	if sc.Seed != 0 {
+		setSeed(sc.Seed)
+		log.Printf("Seed %d: operations run one at a time on a virtual clock, and the server routes messages as a simulation", sc.Seed)
 	}
 
-	binaryName := requestType.String()
+	binaryPath := sc.Binary
+	if binaryPath == "" {
+		binaryPath = sc.Workloads[0].Request
+	}
+	nodeIDs := sc.nodeIDs()
 
-	conn, err := grpc.NewClient("localhost:5051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient("localhost:5051",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(report.intercept))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 	defer conn.Close()
 
-	initClient := initpb.NewInitServiceClient(conn)
-	echoClient := echopb.NewEchoServiceClient(conn)
-	uniqueIdsClient := uniqueidpb.NewUniqueIdsServiceClient(conn)
-	broadcastClient := broadcastpb.NewBroadcastServiceClient(conn)
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
-	defer cancel()
+	c := newClients(conn)
+	ctx := context.Background()
 
-	setBinaryNameReq := &initpb.SetBinaryNameRequest{
-		BinaryName: binaryName,
+	launchReq := &initpb.LaunchRequest{
+		Path: binaryPath,
+		Args: sc.Args,
+		Seed: sc.Seed,
 	}
 
-	_, err = initClient.SetBinaryName(ctx, setBinaryNameReq)
+	launchRes, err := c.init.Launch(ctx, launchReq)
 	if err != nil {
-		log.Fatalf("failed to set binary name: %v", err)
+		log.Fatalf("failed to launch binary: %v", err)
+	}
+	log.Printf("Launching %s", launchRes.Path)
+
+	if sc.ClockSkew > 0 || sc.ClockDrift > 0 {
+		e := nemesisEvent{Clock: randomClocks(nodeIDs, time.Duration(sc.ClockSkew), sc.ClockDrift)}
+		if err := applyNemesis(ctx, c.nemesis, c.process, e); err != nil {
+			log.Fatalf("Failed to set clocks: %v", err)
+		}
+		log.Printf("Set %s", e)
 	}
 
 	initReq := &initpb.InitRequest{
-		Src:  "n1",
-		Dest: "n2",
+		Src:  clientID,
+		Dest: nodeIDs[0],
 		Body: &initpb.InitRequestBody{
 			Type:    "init",
-			NodeId:  "n1",
-			NodeIds: []string{"n1", "n2"},
+			NodeId:  nodeIDs[0],
+			NodeIds: nodeIDs,
 		},
 	}
 
-	initRes, err := initClient.SendInit(ctx, initReq)
+	initRes, err := c.init.SendInit(ctx, initReq)
 	if err != nil {
 		log.Fatalf("Failed to send init request: %v", err)
 	}
 	log.Printf("Response to init: %s", initRes.Body.Type)
 
+	nodeFaults.spec = sc.NodeFaults
+	nodeFaults.processClient = c.process
+	nodeFaults.nodeIDs = nodeIDs
+
+	stopNemesis := startNemesis(c.nemesis, c.process, sc.Nemesis)
+
+	for _, w := range sc.Workloads {
+		if len(sc.Workloads) > 1 {
+			log.Printf("Running workload %s", w.Request)
+		}
+		resetNetStats(ctx, c.stats)
+		phases = append(phases, phase{request: w.Request, from: recorder.Len(), to: -1})
+		load = sc.load(w)
+		runWorkload(ctx, c, sc, w, nodeIDs)
+		phases[len(phases)-1].to = recorder.Len()
+		logNetStats(ctx, c.stats, w.Request)
+	}
+
+	stopNemesis()
+
+	runCheckers(sc.Checkers)
+
+	if err := finish(); err != nil {
+		log.Fatalf("Failed to write results: %v", err)
+	}
+}
+
+// clients are the tester's gRPC clients, all sharing one connection.
+type clients struct {
+	init      initpb.InitServiceClient
+	echo      echopb.EchoServiceClient
+	uniqueIds uniqueidpb.UniqueIdsServiceClient
+	broadcast broadcastpb.BroadcastServiceClient
+	message   maelstrompb.MessageServiceClient
+	gset      gsetpb.GSetServiceClient
+	counter   counterpb.CounterServiceClient
+	kafka     kafkapb.KafkaServiceClient
+	txn       txnpb.TxnServiceClient
+	kv        kvpb.KVServiceClient
+	nemesis   nemesispb.NemesisServiceClient
+	process   processpb.ProcessServiceClient
+	stats     statspb.StatsServiceClient
+}
+
+func newClients(conn *grpc.ClientConn) *clients {
+	return &clients{
+		init:      initpb.NewInitServiceClient(conn),
+		echo:      echopb.NewEchoServiceClient(conn),
+		uniqueIds: uniqueidpb.NewUniqueIdsServiceClient(conn),
+		broadcast: broadcastpb.NewBroadcastServiceClient(conn),
+		message:   maelstrompb.NewMessageServiceClient(conn),
+		gset:      gsetpb.NewGSetServiceClient(conn),
+		counter:   counterpb.NewCounterServiceClient(conn),
+		kafka:     kafkapb.NewKafkaServiceClient(conn),
+		txn:       txnpb.NewTxnServiceClient(conn),
+		kv:        kvpb.NewKVServiceClient(conn),
+		nemesis:   nemesispb.NewNemesisServiceClient(conn),
+		process:   processpb.NewProcessServiceClient(conn),
+		stats:     statspb.NewStatsServiceClient(conn),
+	}
+}
+
+// runWorkload runs w against nodeIDs with the current load.
+func runWorkload(ctx context.Context, c *clients, sc *scenario, w workload, nodeIDs []string) {
+	requestType, _ := parseRequestType(w.Request)
+	settle := time.Duration(sc.Settle)
+
 	switch requestType {
 	case EchoRequest:
-		for i := 0; i < requestCount; i++ {
-			sendEchoRequest(ctx, echoClient, "hello from grpc")
-		}
+		runEcho(ctx, c.echo, nodeIDs)
 	case UniqueIdsRequest:
-		for i := 0; i < requestCount; i++ {
-			sendUniqueIdsRequest(ctx, uniqueIdsClient)
-		}
+		runUniqueIds(ctx, c.uniqueIds, nodeIDs)
 	case BroadcastRequest:
-		sendBroadcastRequest(ctx, broadcastClient, 235)
+		topology := sc.topology()
+		neighbors := topology.build(nodeIDs)
+		log.Printf("Topology %s: %s", topology, describeTopology(neighbors, nodeIDs))
+		runBroadcast(ctx, c.broadcast, nodeIDs, neighbors, settle)
+	case MessageRequest:
+		load.run(load.clients(nodeIDs), func(process, i int) {
+			sendMessageRequest(ctx, c.message, process, nodeIDs[i%len(nodeIDs)], w.Body)
+		})
+	case GSetRequest:
+		runGSet(ctx, c.gset, nodeIDs, settle)
+	case GCounterRequest:
+		runCounter(ctx, c.counter, nodeIDs, settle, false)
+	case PNCounterRequest:
+		runCounter(ctx, c.counter, nodeIDs, settle, true)
+	case KafkaRequest:
+		runKafka(ctx, c.kafka, nodeIDs, sc.Keys, settle)
+	case TxnRWRegisterRequest:
+		runTxn(ctx, c.txn, nodeIDs, sc.Keys, "w")
+	case TxnListAppendRequest:
+		runTxn(ctx, c.txn, nodeIDs, sc.Keys, "append")
+	case LinKVRequest:
+		runLinKV(ctx, c.kv, nodeIDs, sc.Keys)
 	default:
-		log.Fatalf("unknown request type: %s", requestType)
+		fatalf("unknown request type: %s", requestType)
 	}
 }
 
-func sendEchoRequest(ctx context.Context, echoClient echopb.EchoServiceClient, echo string) {
-	echoReq := &echopb.EchoRequest{
-		Src:  "n1",
-		Dest: "n2",
-		Body: &echopb.EchoRequestBody{
-			Type:  "echo",
-			MsgId: 1,
-			Echo:  echo,
-		},
+func sendMessageRequest(ctx context.Context, messageClient maelstrompb.MessageServiceClient, process int, dest string, body string) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	messageReq := &maelstrompb.MessageRequest{
+		Src:     clientID,
+		Dest:    dest,
+		Payload: &maelstrompb.MessageRequest_RawBody{RawBody: body},
 	}
 
-	echoRes, err := echoClient.SendEcho(ctx, echoReq)
+	op := recorder.Invoke(process, "message", body)
+	messageRes, err := messageClient.SendMessage(ctx, messageReq)
+	complete(op, messageRes.GetRawBody(), err)
 	if err != nil {
-		log.Fatalf("Failed to send echo request: %v", err)
+		fatalf("Failed to send message: %v", err)
 	}
-	log.Printf("Response to echo: %s", echoRes.Body.Type)
+	log.Printf("Response from %s: %s", messageRes.Src, messageRes.GetRawBody())
 }
 
-func sendUniqueIdsRequest(ctx context.Context, uniqueIdsClient uniqueidpb.UniqueIdsServiceClient) {
-	uniqueIdsReq := &uniqueidpb.UniqueIdsRequest{
-		Src:  "n1",
-		Dest: "n2",
-		Body: &uniqueidpb.UniqueIdsRequestBody{
-			Type: "generate",
-		},
+// complete records the outcome of op: ok with value when err is nil, and
+// otherwise info or fail with the invoked value, depending on whether the
+// request may still have taken effect.
+func complete(op history.Op, value interface{}, err error) {
+	switch {
+	case err == nil:
+		recorder.Complete(op, history.OK, value, nil)
+	case indeterminate(err):
+		recorder.Complete(op, history.Info, op.Value, err)
+	default:
+		recorder.Complete(op, history.Fail, op.Value, err)
 	}
+}
 
-	uniqueIdsRes, err := uniqueIdsClient.SendUniqueIds(ctx, uniqueIdsReq)
-	if err != nil {
-		log.Fatalf("Failed to send unique IDs request: %v", err)
+// writeHistory writes the operations recorded so far to the -history files.
+func writeHistory() error {
+	if historyPath == "" {
+		return nil
 	}
-	log.Printf("Response to unique IDs: %s", uniqueIdsRes.Body.Type)
+
+	ops := recorder.Ops()
+	for ext, write := range map[string]func(io.Writer, []history.Op) error{
+		".jsonl": history.WriteJSONL,
+		".edn":   history.WriteEDN,
+	} {
+		f, err := os.Create(historyPath + ext)
+		if err != nil {
+			return err
+		}
+		if err := write(f, ops); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+	log.Printf("Wrote %d history events to %s.jsonl and %s.edn", len(ops), historyPath, historyPath)
+	return nil
 }
 
-func sendBroadcastRequest(ctx context.Context, broadcastClient broadcastpb.BroadcastServiceClient, message int32) {
-	broadcastReq := &broadcastpb.BroadcastRequest{
-		Src:  "n1",
-		Dest: "n2",
-		Body: &broadcastpb.BroadcastRequestBody{
-			Type:    "broadcast",
-			Message: message,
-		},
+// finish prints the RPC report and writes the -history and -report files.
+func finish() error {
+	report.print()
+	if err := writeHistory(); err != nil {
+		return fmt.Errorf("history: %w", err)
 	}
-	broadcastRes, err := broadcastClient.SendBroadcast(ctx, broadcastReq)
-	if err != nil {
-		log.Fatalf("Failed to send Broadcast request: %v", err)
+	if err := report.write(); err != nil {
+		return fmt.Errorf("report: %w", err)
 	}
-	log.Printf("Response to broadcast: %s", broadcastRes.Body.Type)
+	return nil
+}
 
-	readReq := &broadcastpb.ReadRequest{
-		Src:  "n1",
-		Dest: "n2",
-		Body: &broadcastpb.ReadRequestBody{
-			Type: "read",
-		},
+// fatalf writes the history and report recorded so far, so that failed runs
+// can be examined, and then exits like log.Fatalf.
+func fatalf(format string, args ...interface{}) {
+	if err := finish(); err != nil {
+		log.Printf("Failed to write results: %v", err)
 	}
-	readRes, err := broadcastClient.SendRead(ctx, readReq)
-	if err != nil {
-		log.Fatalf("Failed to send Read request: %v", err)
-	}
-	log.Printf("Response to read: %s", readRes.Body.Type)
-
-	topologyReq := &broadcastpb.TopologyRequest{
-		Src:  "n1",
-		Dest: "n2",
-		Body: &broadcastpb.TopologyRequestBody{
-			Type: "topology",
-			Topology: map[string]*broadcastpb.Topology{
-				"n1": {Neighbors: []string{"n2", "n3"}},
-				"n2": {Neighbors: []string{"n1"}},
-				"n3": {Neighbors: []string{"n1"}},
-			},
-		},
-	}
-	topologyRes, err := broadcastClient.SendTopology(ctx, topologyReq)
-	if err != nil {
-		log.Fatalf("Failed to send topology request: %v", err)
+	log.Fatalf(format, args...)
+}
+
+// indeterminate reports whether a failed request may still have taken
+// effect on the node, as opposed to being definitely rejected.
+func indeterminate(err error) bool {
+	switch status.Code(err) {
+	case codes.DeadlineExceeded, codes.Canceled, codes.Unavailable, codes.Unknown, codes.Internal:
+		return true
+	default:
+		return false
 	}
-	log.Printf("Response to topology: %s", topologyRes.Body.Type)
 }
 
 func parseRequestType(requestTypeStr string) (RequestType, error) {
@@ -203,6 +404,22 @@ func parseRequestType(requestTypeStr string) (RequestType, error) {
 		return UniqueIdsRequest, nil
 	case "broadcast":
 		return BroadcastRequest, nil
+	case "message":
+		return MessageRequest, nil
+	case "g_set":
+		return GSetRequest, nil
+	case "g_counter":
+		return GCounterRequest, nil
+	case "pn_counter":
+		return PNCounterRequest, nil
+	case "kafka":
+		return KafkaRequest, nil
+	case "txn_rw_register":
+		return TxnRWRegisterRequest, nil
+	case "txn_list_append":
+		return TxnListAppendRequest, nil
+	case "lin_kv":
+		return LinKVRequest, nil
 	default:
 		return UnknownRequest, fmt.Errorf("unknown request type: %s", requestTypeStr)
 	}
@@ -0,0 +1,406 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	nemesispb "github.com/Shresth72/go_gRPC_tester/proto/nemesis"
+	processpb "github.com/Shresth72/go_gRPC_tester/proto/process"
+)
+
+// nemesisEvent is one fault in a scenario's nemesis schedule, applied At
+// after the first workload starts. Exactly one action is set:
+//
+//   - partition: split the nodes into groups that cannot reach each other
+//   - heal: remove the partition
+//   - faults: set the message faults, in the form of the SetFaults RPC's
+//     Faults, e.g. {"drop_probability": 0.1, "latency_ms": 20}
+//   - kill: send signal (SIGKILL by default) to a node's process
+//   - stop, start, restart: stop, start or restart a node's process
+//   - clock: set the clocks of some nodes, e.g.
+//     {"n1": {"offset": "-2s", "drift_ppm": 500}}
+type nemesisEvent struct {
+	At        duration                `json:"at"`
+	Partition [][]string              `json:"partition,omitempty"`
+	Heal      bool                    `json:"heal,omitempty"`
+	Faults    json.RawMessage         `json:"faults,omitempty"`
+	Kill      string                  `json:"kill,omitempty"`
+	Signal    string                  `json:"signal,omitempty"`
+	Stop      string                  `json:"stop,omitempty"`
+	Start     string                  `json:"start,omitempty"`
+	Restart   string                  `json:"restart,omitempty"`
+	Clock     map[string]clockSetting `json:"clock,omitempty"`
+}
+
+// clockSetting is the skew of one node's clock: an offset from the real
+// time, and a drift away from it in microseconds per second.
+type clockSetting struct {
+	Offset   duration `json:"offset"`
+	DriftPPM float64  `json:"drift_ppm"`
+}
+
+func (e nemesisEvent) String() string {
+	switch {
+	case e.Partition != nil:
+		return fmt.Sprintf("partition %v", e.Partition)
+	case e.Heal:
+		return "heal"
+	case e.Faults != nil:
+		return fmt.Sprintf("faults %s", e.Faults)
+	case e.Kill != "":
+		if e.Signal != "" {
+			return fmt.Sprintf("kill %s with %s", e.Kill, e.Signal)
+		}
+		return fmt.Sprintf("kill %s", e.Kill)
+	case e.Stop != "":
+		return fmt.Sprintf("stop %s", e.Stop)
+	case e.Start != "":
+		return fmt.Sprintf("start %s", e.Start)
+	case e.Restart != "":
+		return fmt.Sprintf("restart %s", e.Restart)
+	case e.Clock != nil:
+		ids := make([]string, 0, len(e.Clock))
+		for id := range e.Clock {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		clocks := make([]string, len(ids))
+		for i, id := range ids {
+			c := e.Clock[id]
+			clocks[i] = fmt.Sprintf("%s offset %s drift %gppm", id, time.Duration(c.Offset).Round(time.Millisecond), c.DriftPPM)
+		}
+		return "clock " + strings.Join(clocks, ", ")
+	}
+	return "nothing"
+}
+
+func (e nemesisEvent) faults() (*nemesispb.Faults, error) {
+	f := &nemesispb.Faults{}
+	if err := protojson.Unmarshal(e.Faults, f); err != nil {
+		return nil, fmt.Errorf("invalid faults: %w", err)
+	}
+	return f, nil
+}
+
+// validate checks that e sets exactly one action and names only nodes.
+func (e nemesisEvent) validate(nodes map[string]bool) error {
+	if e.At < 0 {
+		return fmt.Errorf("at cannot be negative: %s", time.Duration(e.At))
+	}
+
+	actions := 0
+	var named []string
+	if e.Partition != nil {
+		actions++
+		for _, group := range e.Partition {
+			named = append(named, group...)
+		}
+	}
+	if e.Heal {
+		actions++
+	}
+	if e.Faults != nil {
+		actions++
+		if _, err := e.faults(); err != nil {
+			return err
+		}
+	}
+	if e.Clock != nil {
+		actions++
+		for id := range e.Clock {
+			named = append(named, id)
+		}
+	}
+	for _, id := range []string{e.Kill, e.Stop, e.Start, e.Restart} {
+		if id != "" {
+			actions++
+			named = append(named, id)
+		}
+	}
+	if e.Signal != "" && e.Kill == "" {
+		return fmt.Errorf("signal is only used with kill")
+	}
+	if actions != 1 {
+		return fmt.Errorf("need exactly one of partition, heal, faults, kill, stop, start, restart or clock, got %d", actions)
+	}
+
+	for _, id := range named {
+		if !nodes[id] {
+			return fmt.Errorf("unknown node %q", id)
+		}
+	}
+	return nil
+}
+
+// startNemesis applies events at their scheduled times, measured from when
+// it is called, until they are all done or the returned function is called.
+// Events that fail are logged and do not stop the run. In a seeded run the
+// times are on the virtual clock, and events are applied between operations.
+func startNemesis(nemesisClient nemesispb.NemesisServiceClient, processClient processpb.ProcessServiceClient, events []nemesisEvent) (stop func()) {
+	events = append([]nemesisEvent(nil), events...)
+	sort.SliceStable(events, func(i, j int) bool { return events[i].At < events[j].At })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	apply := func(e nemesisEvent) {
+		if err := applyNemesis(ctx, nemesisClient, processClient, e); err != nil {
+			log.Printf("nemesis: %s failed: %v", e, err)
+			return
+		}
+		log.Printf("nemesis: %s", e)
+	}
+
+	if seed != 0 {
+		timers := make([]*virtualTimer, len(events))
+		for i, e := range events {
+			e := e
+			timers[i] = virtual.after(time.Duration(e.At), func() { apply(e) })
+		}
+		return func() {
+			remaining := 0
+			for _, t := range timers {
+				if virtual.stop(t) {
+					remaining++
+				}
+			}
+			if remaining > 0 {
+				log.Printf("nemesis: workloads finished before %d remaining events", remaining)
+			}
+			cancel()
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		start := time.Now()
+		for i, e := range events {
+			wait := time.NewTimer(time.Until(start.Add(time.Duration(e.At))))
+			select {
+			case <-wait.C:
+			case <-ctx.Done():
+				wait.Stop()
+				log.Printf("nemesis: workloads finished before %d remaining events", len(events)-i)
+				return
+			}
+
+			apply(e)
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+func applyNemesis(ctx context.Context, nemesisClient nemesispb.NemesisServiceClient, processClient processpb.ProcessServiceClient, e nemesisEvent) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	var err error
+	switch {
+	case e.Partition != nil:
+		groups := make([]*nemesispb.NodeGroup, len(e.Partition))
+		for i, nodes := range e.Partition {
+			groups[i] = &nemesispb.NodeGroup{Nodes: nodes}
+		}
+		_, err = nemesisClient.Partition(ctx, &nemesispb.PartitionRequest{Groups: groups})
+	case e.Heal:
+		_, err = nemesisClient.Heal(ctx, &nemesispb.HealRequest{})
+	case e.Faults != nil:
+		var faults *nemesispb.Faults
+		if faults, err = e.faults(); err == nil {
+			_, err = nemesisClient.SetFaults(ctx, &nemesispb.SetFaultsRequest{Faults: faults})
+		}
+	case e.Kill != "":
+		_, err = processClient.Kill(ctx, &processpb.KillRequest{NodeId: e.Kill, Signal: e.Signal})
+	case e.Stop != "":
+		_, err = processClient.Stop(ctx, &processpb.StopRequest{NodeId: e.Stop})
+	case e.Start != "":
+		_, err = processClient.Start(ctx, &processpb.StartRequest{NodeId: e.Start})
+	case e.Restart != "":
+		_, err = processClient.Restart(ctx, &processpb.RestartRequest{NodeId: e.Restart})
+	case e.Clock != nil:
+		err = setClocks(ctx, nemesisClient, e.Clock)
+	}
+	return err
+}
+
+// crashNemesis crashes or pauses one random node at a time while a workload
+// issues operations: every Interval it alternates between faulting a node,
+// with a fault drawn from Faults, and recovering it. "kill" sends SIGKILL
+// and later starts the node again, which the server follows with init;
+// "pause" sends SIGSTOP and later SIGCONT.
+type crashNemesis struct {
+	Faults   []string `json:"faults"`
+	Interval duration `json:"interval"`
+}
+
+func (cn *crashNemesis) validate() error {
+	if len(cn.Faults) == 0 {
+		return nil
+	}
+	for _, f := range cn.Faults {
+		if f != "kill" && f != "pause" {
+			return fmt.Errorf("unknown node fault %q, want kill or pause", f)
+		}
+	}
+	if cn.Interval <= 0 {
+		return fmt.Errorf("node fault interval must be positive: %s", time.Duration(cn.Interval))
+	}
+	return nil
+}
+
+// nodeFaults is the crash nemesis of the run, set up by startNodeFaults.
+var nodeFaults struct {
+	spec          crashNemesis
+	processClient processpb.ProcessServiceClient
+	nodeIDs       []string
+}
+
+// startNodeFaults runs the crash nemesis, if one is configured, until the
+// returned function is called, which also recovers the node that is down.
+// In a seeded run the interval is measured on the virtual clock.
+func startNodeFaults() (stop func()) {
+	spec := nodeFaults.spec
+	if len(spec.Faults) == 0 {
+		return func() {}
+	}
+
+	var fault, node string
+	step := func() {
+		if node != "" {
+			recoverNode(fault, node)
+			node = ""
+			return
+		}
+		fault = spec.Faults[rng.Intn(len(spec.Faults))]
+		node = randomNode(nodeFaults.nodeIDs)
+		if !faultNode(fault, node) {
+			node = ""
+		}
+	}
+
+	if seed != 0 {
+		timer := virtual.every(time.Duration(spec.Interval), step)
+		return func() {
+			virtual.stop(timer)
+			if node != "" {
+				recoverNode(fault, node)
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(time.Duration(spec.Interval))
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				if node != "" {
+					recoverNode(fault, node)
+				}
+				return
+			}
+			step()
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// faultNode kills or pauses node and reports whether it took effect.
+func faultNode(fault, node string) bool {
+	e := nemesisEvent{Kill: node, Signal: "SIGKILL"}
+	if fault == "pause" {
+		e.Signal = "SIGSTOP"
+	}
+	if err := applyNemesis(context.Background(), nil, nodeFaults.processClient, e); err != nil {
+		log.Printf("nemesis: %s failed: %v", e, err)
+		return false
+	}
+	log.Printf("nemesis: %s %s", map[string]string{"kill": "killed", "pause": "paused"}[fault], node)
+	return true
+}
+
+// recoverNode undoes faultNode. A killed node is started again, retrying
+// while the server has not yet seen the old process exit.
+func recoverNode(fault, node string) {
+	e := nemesisEvent{Kill: node, Signal: "SIGCONT"}
+	if fault == "kill" {
+		e = nemesisEvent{Start: node}
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := applyNemesis(context.Background(), nil, nodeFaults.processClient, e)
+		if err == nil {
+			if fault == "kill" {
+				log.Printf("nemesis: restarted %s", node)
+			} else {
+				log.Printf("nemesis: resumed %s", node)
+			}
+			return
+		}
+		if status.Code(err) != codes.FailedPrecondition || attempt == 10 {
+			log.Printf("nemesis: %s failed: %v", e, err)
+			return
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// setClocks sets the clocks of the nodes in clocks, in the order of their
+// IDs so that a seeded run sends the same requests every time.
+func setClocks(ctx context.Context, nemesisClient nemesispb.NemesisServiceClient, clocks map[string]clockSetting) error {
+	ids := make([]string, 0, len(clocks))
+	for id := range clocks {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	req := &nemesispb.SetClockRequest{}
+	for _, id := range ids {
+		c := clocks[id]
+		req.Clocks = append(req.Clocks, &nemesispb.NodeClock{
+			NodeId:   id,
+			OffsetMs: int32(time.Duration(c.Offset).Milliseconds()),
+			DriftPpm: c.DriftPPM,
+		})
+	}
+	_, err := nemesisClient.SetClock(ctx, req)
+	return err
+}
+
+// randomClocks gives every node a clock offset drawn uniformly from
+// [-skew, skew] and a drift drawn from [-drift, drift] ppm.
+func randomClocks(nodeIDs []string, skew time.Duration, drift float64) map[string]clockSetting {
+	clocks := make(map[string]clockSetting, len(nodeIDs))
+	for _, id := range nodeIDs {
+		offset := time.Duration((2*rng.Float64() - 1) * float64(skew))
+		clocks[id] = clockSetting{
+			Offset:   duration(offset.Round(time.Millisecond)),
+			DriftPPM: math.Round((2*rng.Float64()-1)*drift*10) / 10,
+		}
+	}
+	return clocks
+}
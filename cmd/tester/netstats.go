@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	statspb "github.com/Shresth72/go_gRPC_tester/proto/stats"
+)
+
+// resetNetStats starts the server's network statistics afresh, so that they
+// cover the next workload only.
+func resetNetStats(ctx context.Context, statsClient statspb.StatsServiceClient) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	if _, err := statsClient.ResetNetStats(ctx, &statspb.ResetNetStatsRequest{}); err != nil {
+		log.Printf("Failed to reset network statistics: %v", err)
+	}
+}
+
+// logNetStats fetches the server's network statistics for workload, logs
+// the inter-node messages per client operation and the traffic by message
+// type, and adds them to the report.
+func logNetStats(ctx context.Context, statsClient statspb.StatsServiceClient, workload string) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	st, err := statsClient.GetNetStats(ctx, &statspb.GetNetStatsRequest{})
+	if err != nil {
+		log.Printf("Failed to get network statistics: %v", err)
+		return
+	}
+
+	log.Printf("network: %d inter-node messages (%s, %d dropped) for %d client operations: %.2f messages per operation",
+		st.Messages, formatBytes(st.Bytes), st.Dropped, st.ClientOperations, st.MessagesPerOperation)
+	for _, c := range st.ByType {
+		log.Printf("network: %-20s %8d messages %10s", c.Key, c.Messages, formatBytes(c.Bytes))
+	}
+	if st.ServiceMessages > 0 {
+		log.Printf("network: %d requests to built-in services", st.ServiceMessages)
+	}
+
+	ws := workloadNetStats{
+		Workload:             workload,
+		ClientOperations:     st.ClientOperations,
+		Messages:             st.Messages,
+		Bytes:                st.Bytes,
+		Dropped:              st.Dropped,
+		MessagesPerOperation: st.MessagesPerOperation,
+		ServiceMessages:      st.ServiceMessages,
+		ByType:               make(map[string]messageCount, len(st.ByType)),
+		Links:                make(map[string]messageCount, len(st.Links)),
+	}
+	for _, c := range st.ByType {
+		ws.ByType[c.Key] = messageCount{Messages: c.Messages, Bytes: c.Bytes}
+	}
+	for _, l := range st.Links {
+		ws.Links[l.Src+" "+l.Dest] = messageCount{Messages: l.Messages, Bytes: l.Bytes}
+	}
+
+	report.mu.Lock()
+	report.network = append(report.network, ws)
+	report.mu.Unlock()
+}
+
+// workloadNetStats is the network statistics of one workload in the JSON
+// report. Links are keyed by "src dest".
+type workloadNetStats struct {
+	Workload             string                  `json:"workload"`
+	ClientOperations     int64                   `json:"client_operations"`
+	Messages             int64                   `json:"messages"`
+	Bytes                int64                   `json:"bytes"`
+	Dropped              int64                   `json:"dropped"`
+	MessagesPerOperation float64                 `json:"messages_per_operation"`
+	ServiceMessages      int64                   `json:"service_messages"`
+	ByType               map[string]messageCount `json:"by_type"`
+	Links                map[string]messageCount `json:"links"`
+}
+
+type messageCount struct {
+	Messages int64 `json:"messages"`
+	Bytes    int64 `json:"bytes"`
+}
+
+func formatBytes(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MiB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KiB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%d B", n)
+}
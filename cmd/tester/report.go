@@ -0,0 +1,306 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/Shresth72/go_gRPC_tester/metrics"
+)
+
+// reportQuantiles are the latency quantiles in the summary table and the
+// -report files, with their column names.
+var reportQuantiles = []struct {
+	name string
+	q    float64
+}{
+	{"p50", 0.5},
+	{"p90", 0.9},
+	{"p99", 0.99},
+	{"p999", 0.999},
+}
+
+// rpcStats is what the report tracks for one RPC method.
+type rpcStats struct {
+	latency metrics.Histogram
+	// errors counts failed calls by gRPC status code.
+	errors map[string]int
+	// perSecond counts completed calls in each second of the run.
+	perSecond []int
+}
+
+// rpcReport collects latency, error and throughput statistics for every RPC
+// the tester makes. It is filled in by intercept and written out by print
+// and write.
+type rpcReport struct {
+	mu    sync.Mutex
+	start time.Time
+	rpcs  map[string]*rpcStats
+	// network holds the server's network statistics of each workload.
+	network []workloadNetStats
+}
+
+// report holds the statistics of the run; reportPath is the -report file.
+var (
+	report     = &rpcReport{start: time.Now(), rpcs: make(map[string]*rpcStats)}
+	reportPath string
+)
+
+// intercept is a grpc.UnaryClientInterceptor that times each call and
+// records it under the method's name.
+func (r *rpcReport) intercept(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	end := time.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	name := path.Base(method)
+	s := r.rpcs[name]
+	if s == nil {
+		s = &rpcStats{errors: make(map[string]int)}
+		r.rpcs[name] = s
+	}
+	s.latency.Record(end.Sub(start))
+	if err != nil {
+		s.errors[status.Code(err).String()]++
+	}
+	second := int(end.Sub(r.start) / time.Second)
+	for len(s.perSecond) <= second {
+		s.perSecond = append(s.perSecond, 0)
+	}
+	s.perSecond[second]++
+	return err
+}
+
+// methods returns the names of the RPCs made so far, in order. Callers must
+// hold r.mu.
+func (r *rpcReport) methods() []string {
+	names := make([]string, 0, len(r.rpcs))
+	for name := range r.rpcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// slower returns the q-th quantile latency of each RPC over limit, checking
+// only rpc if it is set and otherwise every Send RPC.
+func (r *rpcReport) slower(rpc string, q float64, limit time.Duration) map[string]time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	slow := make(map[string]time.Duration)
+	for name, s := range r.rpcs {
+		if rpc != "" && name != rpc || rpc == "" && !strings.HasPrefix(name, "Send") {
+			continue
+		}
+		if d := s.latency.Quantile(q); d > limit {
+			slow[name] = d
+		}
+	}
+	return slow
+}
+
+func (s *rpcStats) errorCount() int {
+	n := 0
+	for _, c := range s.errors {
+		n += c
+	}
+	return n
+}
+
+// errorSummary lists the error counts by code, e.g. "DeadlineExceeded=3".
+func (s *rpcStats) errorSummary() string {
+	codes := make([]string, 0, len(s.errors))
+	for code, n := range s.errors {
+		codes = append(codes, fmt.Sprintf("%s=%d", code, n))
+	}
+	sort.Strings(codes)
+	return strings.Join(codes, " ")
+}
+
+// print logs a table of every RPC's call and error counts, mean throughput
+// and latency quantiles, followed by the errors by code and the calls
+// completed in each second.
+func (r *rpcReport) print() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.rpcs) == 0 {
+		return
+	}
+
+	elapsed := time.Since(r.start)
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-16s %8s %7s %9s", "rpc", "calls", "errors", "calls/s")
+	for _, q := range reportQuantiles {
+		fmt.Fprintf(&b, " %9s", q.name)
+	}
+	fmt.Fprintf(&b, " %9s", "max")
+	log.Print(b.String())
+
+	for _, name := range r.methods() {
+		s := r.rpcs[name]
+		b.Reset()
+		fmt.Fprintf(&b, "%-16s %8d %7d %9.1f", name, s.latency.Count(), s.errorCount(), float64(s.latency.Count())/elapsed.Seconds())
+		for _, q := range reportQuantiles {
+			fmt.Fprintf(&b, " %9s", formatLatency(s.latency.Quantile(q.q)))
+		}
+		fmt.Fprintf(&b, " %9s", formatLatency(s.latency.Max()))
+		log.Print(b.String())
+	}
+
+	for _, name := range r.methods() {
+		if s := r.rpcs[name]; len(s.errors) > 0 {
+			log.Printf("%s errors: %s", name, s.errorSummary())
+		}
+	}
+	for _, name := range r.methods() {
+		s := r.rpcs[name]
+		if len(s.perSecond) > 1 {
+			log.Printf("%s calls per second: %v", name, s.perSecond)
+		}
+	}
+}
+
+// formatLatency rounds d to three significant digits for the table.
+func formatLatency(d time.Duration) string {
+	switch {
+	case d >= time.Second:
+		return d.Round(10 * time.Millisecond).String()
+	case d >= time.Millisecond:
+		return d.Round(10 * time.Microsecond).String()
+	case d >= time.Microsecond:
+		return d.Round(10 * time.Nanosecond).String()
+	}
+	return d.String()
+}
+
+// rpcSummary is one RPC in the JSON report. Latencies are in milliseconds.
+type rpcSummary struct {
+	RPC        string             `json:"rpc"`
+	Calls      int64              `json:"calls"`
+	Errors     map[string]int     `json:"errors"`
+	Throughput float64            `json:"calls_per_second"`
+	Latency    map[string]float64 `json:"latency_ms"`
+	PerSecond  []int              `json:"calls_per_second_over_time"`
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func (r *rpcReport) summaries() []rpcSummary {
+	elapsed := time.Since(r.start)
+	summaries := make([]rpcSummary, 0, len(r.rpcs))
+	for _, name := range r.methods() {
+		s := r.rpcs[name]
+		latency := map[string]float64{
+			"min":  milliseconds(s.latency.Min()),
+			"mean": milliseconds(s.latency.Mean()),
+			"max":  milliseconds(s.latency.Max()),
+		}
+		for _, q := range reportQuantiles {
+			latency[q.name] = milliseconds(s.latency.Quantile(q.q))
+		}
+		summaries = append(summaries, rpcSummary{
+			RPC:        name,
+			Calls:      s.latency.Count(),
+			Errors:     s.errors,
+			Throughput: float64(s.latency.Count()) / elapsed.Seconds(),
+			Latency:    latency,
+			PerSecond:  s.perSecond,
+		})
+	}
+	return summaries
+}
+
+// writeJSON writes the report as one JSON object with the run's duration,
+// a summary per RPC and the network statistics of each workload.
+func (r *rpcReport) writeJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Duration float64            `json:"duration_seconds"`
+		RPCs     []rpcSummary       `json:"rpcs"`
+		Network  []workloadNetStats `json:"network,omitempty"`
+	}{time.Since(r.start).Seconds(), r.summaries(), r.network})
+}
+
+// writeCSV writes the summary table as CSV, one row per RPC. The errors
+// column lists the counts by code.
+func (r *rpcReport) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{"rpc", "calls", "errors", "calls_per_second", "min_ms", "mean_ms"}
+	for _, q := range reportQuantiles {
+		header = append(header, q.name+"_ms")
+	}
+	header = append(header, "max_ms", "errors_by_code")
+	cw.Write(header)
+
+	ms := func(d time.Duration) string {
+		return strconv.FormatFloat(milliseconds(d), 'f', 3, 64)
+	}
+	elapsed := time.Since(r.start)
+	for _, name := range r.methods() {
+		s := r.rpcs[name]
+		row := []string{
+			name,
+			strconv.FormatInt(s.latency.Count(), 10),
+			strconv.Itoa(s.errorCount()),
+			strconv.FormatFloat(float64(s.latency.Count())/elapsed.Seconds(), 'f', 1, 64),
+			ms(s.latency.Min()),
+			ms(s.latency.Mean()),
+		}
+		for _, q := range reportQuantiles {
+			row = append(row, ms(s.latency.Quantile(q.q)))
+		}
+		row = append(row, ms(s.latency.Max()), s.errorSummary())
+		cw.Write(row)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// write writes the report to the -report file, as JSON or CSV depending on
+// its extension.
+func (r *rpcReport) write() error {
+	if reportPath == "" {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	write := r.writeJSON
+	if strings.EqualFold(filepath.Ext(reportPath), ".csv") {
+		write = r.writeCSV
+	}
+
+	f, err := os.Create(reportPath)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	log.Printf("Wrote the RPC report to %s", reportPath)
+	return nil
+}
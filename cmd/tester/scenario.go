@@ -0,0 +1,374 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/Shresth72/go_gRPC_tester/history"
+)
+
+// scenario is a test plan: the cluster to launch, the workloads to run
+// against it one after another, the faults to inject while they run and the
+// checkers to apply to the whole history afterwards. It is built from the
+// command-line flags, or read from the JSON file given with -scenario, in
+// which case flags set on the command line take precedence.
+type scenario struct {
+	Binary string   `json:"binary"`
+	Args   []string `json:"args"`
+	Nodes  int      `json:"nodes"`
+	// Topology is the broadcast workload's topology: the name of a shape, or
+	// a map of each node to its neighbors. Branching and Degree configure
+	// the tree and regular shapes.
+	Topology  topologySpec `json:"topology"`
+	Branching int          `json:"branching"`
+	Degree    int          `json:"degree"`
+	Keys      int          `json:"keys"`
+	Timeout   duration     `json:"timeout"`
+	Settle    duration     `json:"settle"`
+	History   string       `json:"history"`
+	Report    string       `json:"report"`
+	// Seed, if not zero, makes the run deterministic so that it can be
+	// replayed exactly.
+	Seed int64 `json:"seed"`
+
+	// The load settings are the defaults of every workload.
+	loadSettings
+
+	Workloads []workload     `json:"workloads"`
+	Nemesis   []nemesisEvent `json:"nemesis"`
+	// ClockSkew and ClockDrift give every node a random clock offset of up
+	// to ClockSkew and drift of up to ClockDrift ppm, either way, from the
+	// start.
+	ClockSkew  duration `json:"clock_skew"`
+	ClockDrift float64  `json:"clock_drift_ppm"`
+	// NodeFaults crashes and pauses random nodes while workloads issue
+	// operations.
+	NodeFaults crashNemesis  `json:"node_faults"`
+	Checkers   []checkerSpec `json:"checkers"`
+}
+
+// loadSettings are the JSON form of a loadSpec. Zero values are unset,
+// except for Rate, which is nil when unset so that a workload can set a rate
+// of 0 to run closed-loop.
+type loadSettings struct {
+	Count       int      `json:"count"`
+	Concurrency int      `json:"concurrency"`
+	Rate        *float64 `json:"rate"`
+	Arrival     string   `json:"arrival"`
+	Duration    duration `json:"duration"`
+}
+
+// workload is one workload of a scenario. Load settings it leaves unset are
+// taken from the scenario.
+type workload struct {
+	Request string `json:"request"`
+	// Body is the JSON message body of a message workload.
+	Body string `json:"body"`
+	loadSettings
+}
+
+// checkerSpec names a checker to run over the whole scenario, on top of the
+// checks every workload makes of its own results.
+//
+//   - availability: at least Min of all client operations completed ok
+//   - latency: the Quantile latency of RPC, or of every Send RPC if RPC is
+//     empty, is at most Max
+type checkerSpec struct {
+	Name     string   `json:"name"`
+	Min      float64  `json:"min"`
+	RPC      string   `json:"rpc"`
+	Quantile float64  `json:"quantile"`
+	Max      duration `json:"max"`
+}
+
+// duration is a time.Duration written in JSON as a string such as "1.5s".
+type duration time.Duration
+
+func (d *duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"10s\", not %s", data)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = duration(v)
+	return nil
+}
+
+func (d duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func defaultScenario() *scenario {
+	return &scenario{
+		Nodes:        1,
+		Topology:     topologySpec{shape: "total"},
+		Branching:    2,
+		Degree:       3,
+		Keys:         8,
+		Timeout:      duration(time.Second),
+		Settle:       duration(3 * time.Second),
+		NodeFaults:   crashNemesis{Interval: duration(2 * time.Second)},
+		loadSettings: loadSettings{Arrival: "bucket"},
+	}
+}
+
+// loadScenario reads the scenario in path and applies the settings of cli
+// whose flags were set on the command line.
+func loadScenario(path string, cli *scenario) (*scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario: %w", err)
+	}
+	sc := defaultScenario()
+	if err := json.Unmarshal(data, sc); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario %s: %w", path, err)
+	}
+
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "request":
+			sc.Workloads = cli.Workloads
+		case "body":
+			if len(cli.Workloads) == 0 {
+				body := flag.Lookup("body").Value.String()
+				for i := range sc.Workloads {
+					if sc.Workloads[i].Request == MessageRequest.String() {
+						sc.Workloads[i].Body = body
+					}
+				}
+			}
+		case "binary":
+			sc.Binary = cli.Binary
+		case "nodes":
+			sc.Nodes = cli.Nodes
+		case "topology":
+			sc.Topology = cli.Topology
+		case "branching":
+			sc.Branching = cli.Branching
+		case "degree":
+			sc.Degree = cli.Degree
+		case "keys":
+			sc.Keys = cli.Keys
+		case "timeout":
+			sc.Timeout = cli.Timeout
+		case "settle":
+			sc.Settle = cli.Settle
+		case "history":
+			sc.History = cli.History
+		case "report":
+			sc.Report = cli.Report
+		case "seed":
+			sc.Seed = cli.Seed
+		case "count":
+			sc.Count = cli.Count
+		case "concurrency":
+			sc.Concurrency = cli.Concurrency
+		case "rate":
+			sc.Rate = cli.Rate
+		case "arrival":
+			sc.Arrival = cli.Arrival
+		case "duration":
+			sc.Duration = cli.Duration
+		case "clock-skew":
+			sc.ClockSkew = cli.ClockSkew
+		case "clock-drift":
+			sc.ClockDrift = cli.ClockDrift
+		case "node-faults":
+			sc.NodeFaults.Faults = cli.NodeFaults.Faults
+		case "node-fault-interval":
+			sc.NodeFaults.Interval = cli.NodeFaults.Interval
+		}
+	})
+	if len(cli.Args) > 0 {
+		sc.Args = cli.Args
+	}
+
+	return sc, nil
+}
+
+// nodeIDs returns the names of the scenario's nodes, n1 to nN.
+func (sc *scenario) nodeIDs() []string {
+	ids := make([]string, sc.Nodes)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("n%d", i+1)
+	}
+	return ids
+}
+
+// topology returns the broadcast topology with its shape's settings.
+func (sc *scenario) topology() topologySpec {
+	t := sc.Topology
+	t.branching, t.degree = sc.Branching, sc.Degree
+	return t
+}
+
+// load returns the load of w, filling in what it leaves unset from sc.
+func (sc *scenario) load(w workload) loadSpec {
+	l := loadSpec{
+		count:       sc.Count,
+		concurrency: sc.Concurrency,
+		arrival:     sc.Arrival,
+		duration:    time.Duration(sc.Duration),
+	}
+	if w.Count != 0 {
+		l.count = w.Count
+	}
+	if w.Concurrency != 0 {
+		l.concurrency = w.Concurrency
+	}
+	if sc.Rate != nil {
+		l.rate = *sc.Rate
+	}
+	if w.Rate != nil {
+		l.rate = *w.Rate
+	}
+	if w.Arrival != "" {
+		l.arrival = w.Arrival
+	}
+	if w.Duration != 0 {
+		l.duration = time.Duration(w.Duration)
+	}
+	return l
+}
+
+func (sc *scenario) validate() error {
+	if sc.Nodes <= 0 {
+		return fmt.Errorf("nodes cannot be less or equal to 0: %d", sc.Nodes)
+	}
+	if sc.Keys <= 0 {
+		return fmt.Errorf("keys cannot be less or equal to 0: %d", sc.Keys)
+	}
+	if len(sc.Workloads) == 0 {
+		return fmt.Errorf("need a -request or a scenario with workloads")
+	}
+
+	nodes := make(map[string]bool)
+	for _, id := range sc.nodeIDs() {
+		nodes[id] = true
+	}
+	if err := sc.topology().validate(sc.nodeIDs()); err != nil {
+		return err
+	}
+
+	for i, w := range sc.Workloads {
+		requestType, err := parseRequestType(w.Request)
+		if err != nil {
+			return fmt.Errorf("workload %d: %w", i+1, err)
+		}
+		if requestType == MessageRequest && (sc.Binary == "" || w.Body == "") {
+			return fmt.Errorf("workload %d: a message workload needs both a binary and a body", i+1)
+		}
+		if err := sc.load(w).validate(); err != nil {
+			return fmt.Errorf("workload %d: %w", i+1, err)
+		}
+	}
+
+	for i, e := range sc.Nemesis {
+		if err := e.validate(nodes); err != nil {
+			return fmt.Errorf("nemesis event %d: %w", i+1, err)
+		}
+	}
+
+	if sc.ClockSkew < 0 || sc.ClockDrift < 0 {
+		return fmt.Errorf("clock skew and drift cannot be negative")
+	}
+
+	if err := sc.NodeFaults.validate(); err != nil {
+		return err
+	}
+
+	for _, c := range sc.Checkers {
+		switch c.Name {
+		case "availability":
+			if c.Min < 0 || c.Min > 1 {
+				return fmt.Errorf("availability min must be in [0, 1]: %g", c.Min)
+			}
+		case "latency":
+			if c.Quantile <= 0 || c.Quantile > 1 {
+				return fmt.Errorf("latency quantile must be in (0, 1]: %g", c.Quantile)
+			}
+			if c.Max <= 0 {
+				return fmt.Errorf("latency max must be positive: %s", time.Duration(c.Max))
+			}
+		default:
+			return fmt.Errorf("unknown checker %q, want availability or latency", c.Name)
+		}
+	}
+	return nil
+}
+
+// phase is the part of the history recorded while one workload ran.
+type phase struct {
+	request  string
+	from, to int
+}
+
+// phases are the workloads run so far, in order.
+var phases []phase
+
+// phaseOps returns the operations recorded by every workload of kind
+// request so far, including one that is still running.
+func phaseOps(request string) []history.Op {
+	ops := recorder.Ops()
+	var out []history.Op
+	for _, p := range phases {
+		if p.request != request {
+			continue
+		}
+		to := p.to
+		if to < 0 {
+			to = len(ops)
+		}
+		out = append(out, ops[p.from:to]...)
+	}
+	return out
+}
+
+// runCheckers applies the scenario's checkers.
+func runCheckers(checkers []checkerSpec) {
+	for _, c := range checkers {
+		switch c.Name {
+		case "availability":
+			ok, completed := 0, 0
+			for _, op := range recorder.Ops() {
+				if op.Type == history.Invoke {
+					continue
+				}
+				completed++
+				if op.Type == history.OK {
+					ok++
+				}
+			}
+			if completed == 0 {
+				fatalf("availability: no operations completed")
+			}
+			availability := float64(ok) / float64(completed)
+			log.Printf("availability: %d of %d operations ok (%.1f%%)", ok, completed, 100*availability)
+			if availability < c.Min {
+				fatalf("availability %.3f is below %.3f", availability, c.Min)
+			}
+		case "latency":
+			slow := report.slower(c.RPC, c.Quantile, time.Duration(c.Max))
+			names := make([]string, 0, len(slow))
+			for name := range slow {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				log.Printf("latency: %s q%g is %s, over %s", name, c.Quantile, slow[name], time.Duration(c.Max))
+			}
+			if len(slow) > 0 {
+				fatalf("latency: %d RPCs are slower than %s at q%g", len(slow), time.Duration(c.Max), c.Quantile)
+			}
+			log.Printf("latency: q%g is within %s", c.Quantile, time.Duration(c.Max))
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// seed is the -seed of the run, or 0 if the run is not seeded. A seeded run
+// is deterministic: every random choice the tester makes comes from rng,
+// operations are issued one at a time on the virtual clock, and the server
+// routes the cluster's messages as a simulation with the same seed.
+var seed int64
+
+// rng is the tester's source of randomness, for workload values, node
+// choices, topologies, clocks and faults.
+var rng = rand.New(&lockedSource{src: rand.NewSource(time.Now().UnixNano())})
+
+// setSeed makes the run deterministic with seed s.
+func setSeed(s int64) {
+	seed = s
+	rng = rand.New(&lockedSource{src: rand.NewSource(s)})
+}
+
+// lockedSource is a rand.Source that is safe for the concurrent clients of
+// a run that is not seeded.
+type lockedSource struct {
+	mu  sync.Mutex
+	src rand.Source
+}
+
+func (s *lockedSource) Int63() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.src.Int63()
+}
+
+func (s *lockedSource) Seed(seed int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.src.Seed(seed)
+}
+
+// closedLoopStep is the virtual time a closed-loop operation takes in a
+// seeded run.
+const closedLoopStep = time.Millisecond
+
+// virtualClock is the clock of a seeded run. Time on it passes only as
+// operations are issued, and timers set on it fire between operations, so
+// that faults land at the same point of every replay.
+type virtualClock struct {
+	mu     sync.Mutex
+	now    time.Duration
+	seq    int
+	timers []*virtualTimer
+}
+
+// virtualTimer calls f at a time on the virtual clock, and again every
+// period after that if period is set.
+type virtualTimer struct {
+	at, period time.Duration
+	seq        int
+	f          func()
+}
+
+// virtual is the clock of the run, measured from the start of the first
+// workload.
+var virtual virtualClock
+
+func (c *virtualClock) Now() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// after calls f once d has passed.
+func (c *virtualClock) after(d time.Duration, f func()) *virtualTimer {
+	return c.add(&virtualTimer{at: c.Now() + d, f: f})
+}
+
+// every calls f each time d passes.
+func (c *virtualClock) every(d time.Duration, f func()) *virtualTimer {
+	return c.add(&virtualTimer{at: c.Now() + d, period: d, f: f})
+}
+
+func (c *virtualClock) add(t *virtualTimer) *virtualTimer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.seq++
+	t.seq = c.seq
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// stop cancels t and reports whether it was still pending.
+func (c *virtualClock) stop(t *virtualTimer) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, pending := range c.timers {
+		if pending == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// advance moves the clock forward to t, firing the timers due by then in
+// the order of their times and, for equal times, of when they were set.
+func (c *virtualClock) advance(t time.Duration) {
+	for {
+		c.mu.Lock()
+		next := -1
+		for i, timer := range c.timers {
+			if timer.at > t {
+				continue
+			}
+			if next < 0 || timer.at < c.timers[next].at || timer.at == c.timers[next].at && timer.seq < c.timers[next].seq {
+				next = i
+			}
+		}
+		if next < 0 {
+			if t > c.now {
+				c.now = t
+			}
+			c.mu.Unlock()
+			return
+		}
+
+		timer := c.timers[next]
+		c.now = timer.at
+		if timer.period > 0 {
+			c.seq++
+			timer.seq = c.seq
+			timer.at += timer.period
+		} else {
+			c.timers = append(c.timers[:next], c.timers[next+1:]...)
+		}
+		c.mu.Unlock()
+
+		timer.f()
+	}
+}
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// topologyShapes are the shapes makeTopology can build.
+var topologyShapes = []string{"line", "ring", "grid", "tree", "total", "regular", "spanning_tree"}
+
+// topologySpec is how the broadcast workload connects the nodes: a shape
+// built from the node IDs, or an explicit neighbor map. In a scenario it is
+// written as the shape's name or as the map.
+type topologySpec struct {
+	shape     string
+	neighbors map[string][]string
+	// branching is the number of children of each node in a tree.
+	branching int
+	// degree is the number of neighbors of each node in a regular topology.
+	degree int
+}
+
+func (t *topologySpec) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &t.shape); err == nil {
+		return nil
+	}
+	if err := json.Unmarshal(data, &t.neighbors); err != nil {
+		return fmt.Errorf("topology must be a shape name or a map of node IDs to neighbors: %s", data)
+	}
+	t.shape = ""
+	return nil
+}
+
+func (t topologySpec) String() string {
+	switch {
+	case t.neighbors != nil:
+		return "explicit"
+	case t.shape == "tree":
+		return fmt.Sprintf("tree with branching %d", t.branching)
+	case t.shape == "regular":
+		return fmt.Sprintf("%d-regular", t.degree)
+	}
+	return t.shape
+}
+
+func (t topologySpec) validate(nodeIDs []string) error {
+	if t.neighbors != nil {
+		known := make(map[string]bool, len(nodeIDs))
+		for _, id := range nodeIDs {
+			known[id] = true
+		}
+		for id, neighbors := range t.neighbors {
+			for _, n := range append([]string{id}, neighbors...) {
+				if !known[n] {
+					return fmt.Errorf("topology names unknown node %q", n)
+				}
+			}
+		}
+		return nil
+	}
+
+	switch t.shape {
+	case "tree":
+		if t.branching < 1 {
+			return fmt.Errorf("tree branching must be at least 1: %d", t.branching)
+		}
+	case "regular":
+		n := len(nodeIDs)
+		if t.degree < 1 || t.degree >= n || n*t.degree%2 != 0 {
+			return fmt.Errorf("no %d-regular topology of %d nodes: the degree must be below the node count and their product even", t.degree, n)
+		}
+	case "line", "ring", "grid", "total", "spanning_tree":
+	default:
+		return fmt.Errorf("unknown topology %q, want %s or a neighbor map", t.shape, strings.Join(topologyShapes, ", "))
+	}
+	return nil
+}
+
+// build returns the neighbors of every node in nodeIDs. Every shape is
+// undirected: if a is a neighbor of b, b is a neighbor of a.
+func (t topologySpec) build(nodeIDs []string) map[string][]string {
+	if t.neighbors != nil {
+		return t.neighbors
+	}
+
+	g := newGraph(nodeIDs)
+	n := len(nodeIDs)
+	switch t.shape {
+	case "line":
+		for i := 1; i < n; i++ {
+			g.connect(i-1, i)
+		}
+	case "ring":
+		for i := 1; i < n; i++ {
+			g.connect(i-1, i)
+		}
+		if n > 2 {
+			g.connect(n-1, 0)
+		}
+	case "grid":
+		width := int(math.Ceil(math.Sqrt(float64(n))))
+		for i := 0; i < n; i++ {
+			if i%width+1 < width && i+1 < n {
+				g.connect(i, i+1)
+			}
+			if i+width < n {
+				g.connect(i, i+width)
+			}
+		}
+	case "tree":
+		for i := 1; i < n; i++ {
+			g.connect((i-1)/t.branching, i)
+		}
+	case "total":
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				g.connect(i, j)
+			}
+		}
+	case "regular":
+		g.regular(t.degree)
+	case "spanning_tree":
+		// Attach each node, in random order, to a random node placed before
+		// it, which gives a random tree spanning the cluster.
+		order := rng.Perm(n)
+		for i := 1; i < n; i++ {
+			g.connect(order[rng.Intn(i)], order[i])
+		}
+	}
+	return g.neighbors()
+}
+
+// graph is an undirected graph over node indexes.
+type graph struct {
+	ids   []string
+	edges []map[int]bool
+}
+
+func newGraph(ids []string) *graph {
+	g := &graph{ids: ids, edges: make([]map[int]bool, len(ids))}
+	for i := range g.edges {
+		g.edges[i] = make(map[int]bool)
+	}
+	return g
+}
+
+func (g *graph) connect(a, b int) {
+	g.edges[a][b] = true
+	g.edges[b][a] = true
+}
+
+func (g *graph) disconnect(a, b int) {
+	delete(g.edges[a], b)
+	delete(g.edges[b], a)
+}
+
+// regularAttempts is how many shuffles regular tries before giving up on
+// finding a connected graph.
+const regularAttempts = 100
+
+// regular connects every node to degree others at random. It starts from a
+// ring lattice, in which each node reaches its degree/2 nearest nodes on
+// either side and, for an odd degree, the node opposite, and shuffles it with
+// swaps that keep every node's degree: edges a-b and c-d become a-d and c-b.
+// Shuffles that disconnect the graph are retried from the lattice, and the
+// run fails if none of regularAttempts shuffles is connected.
+func (g *graph) regular(degree int) {
+	n := len(g.ids)
+	for attempt := 0; attempt < regularAttempts; attempt++ {
+		for i := range g.edges {
+			g.edges[i] = make(map[int]bool)
+		}
+		for i := 0; i < n; i++ {
+			for d := 1; d <= degree/2; d++ {
+				g.connect(i, (i+d)%n)
+			}
+			if degree%2 == 1 {
+				g.connect(i, (i+n/2)%n)
+			}
+		}
+
+		type edge struct{ a, b int }
+		for swaps := 10 * n * degree; swaps > 0; swaps-- {
+			var edges []edge
+			for a := range g.edges {
+				for b := range g.edges[a] {
+					if a < b {
+						edges = append(edges, edge{a, b})
+					}
+				}
+			}
+			sort.Slice(edges, func(i, j int) bool {
+				return edges[i].a < edges[j].a || edges[i].a == edges[j].a && edges[i].b < edges[j].b
+			})
+			e, f := edges[rng.Intn(len(edges))], edges[rng.Intn(len(edges))]
+			if rng.Intn(2) == 0 {
+				f.a, f.b = f.b, f.a
+			}
+			if e.a == f.b || e.b == f.a || e.a == f.a || e.b == f.b || g.edges[e.a][f.b] || g.edges[f.a][e.b] {
+				continue
+			}
+			g.disconnect(e.a, e.b)
+			g.disconnect(f.a, f.b)
+			g.connect(e.a, f.b)
+			g.connect(f.a, e.b)
+		}
+
+		if g.connected() {
+			return
+		}
+	}
+	fatalf("Found no connected %d-regular topology of %d nodes in %d attempts; try another degree", degree, n, regularAttempts)
+}
+
+// distances returns the number of hops from node from to every node, or -1
+// for nodes it cannot reach.
+func (g *graph) distances(from int) []int {
+	dist := make([]int, len(g.ids))
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[from] = 0
+	queue := []int{from}
+	for len(queue) > 0 {
+		a := queue[0]
+		queue = queue[1:]
+		for b := range g.edges[a] {
+			if dist[b] < 0 {
+				dist[b] = dist[a] + 1
+				queue = append(queue, b)
+			}
+		}
+	}
+	return dist
+}
+
+func (g *graph) connected() bool {
+	for _, d := range g.distances(0) {
+		if d < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// neighbors returns the graph as a map of node IDs to sorted neighbor IDs.
+// Every node has an entry, even one without neighbors.
+func (g *graph) neighbors() map[string][]string {
+	topology := make(map[string][]string, len(g.ids))
+	for a, id := range g.ids {
+		neighbors := make([]int, 0, len(g.edges[a]))
+		for b := range g.edges[a] {
+			neighbors = append(neighbors, b)
+		}
+		sort.Ints(neighbors)
+
+		topology[id] = make([]string, len(neighbors))
+		for i, b := range neighbors {
+			topology[id][i] = g.ids[b]
+		}
+	}
+	return topology
+}
+
+// describeTopology summarizes topology as its number of links, the largest
+// number of neighbors of any node and its diameter in hops. Links are
+// counted once per direction a node lists them in.
+func describeTopology(topology map[string][]string, nodeIDs []string) string {
+	index := make(map[string]int, len(nodeIDs))
+	for i, id := range nodeIDs {
+		index[id] = i
+	}
+	g := newGraph(nodeIDs)
+	links, maxDegree := 0, 0
+	for id, neighbors := range topology {
+		links += len(neighbors)
+		if len(neighbors) > maxDegree {
+			maxDegree = len(neighbors)
+		}
+		for _, n := range neighbors {
+			g.edges[index[id]][index[n]] = true
+		}
+	}
+
+	diameter := 0
+	for i := range nodeIDs {
+		for _, d := range g.distances(i) {
+			if d < 0 {
+				return fmt.Sprintf("%d links, max degree %d, not every node can reach every other", links, maxDegree)
+			}
+			if d > diameter {
+				diameter = d
+			}
+		}
+	}
+	return fmt.Sprintf("%d links, max degree %d, diameter %d", links, maxDegree, diameter)
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/Shresth72/go_gRPC_tester/checker/txn"
+	"github.com/Shresth72/go_gRPC_tester/history"
+	txnpb "github.com/Shresth72/go_gRPC_tester/proto/txn"
+)
+
+// txnMaxOps is the largest number of micro-operations in one transaction.
+const txnMaxOps = 4
+
+// runTxn runs random transactions over keys from concurrent clients against
+// random nodes, then checks the recorded results for anomalies. writeOp is
+// "w" for txn-rw-register and "append" for txn-list-append.
+func runTxn(ctx context.Context, txnClient txnpb.TxnServiceClient, nodeIDs []string, keys int, writeOp string) {
+	var mu sync.Mutex
+	var txns []txn.Txn
+	nextValue := make(map[int]int)
+
+	// generate builds a random transaction whose writes use values that are
+	// unique per key, so the checker can tell every write apart.
+	generate := func() []txn.MicroOp {
+		mu.Lock()
+		defer mu.Unlock()
+
+		ops := make([]txn.MicroOp, rng.Intn(txnMaxOps)+1)
+		for i := range ops {
+			key := rng.Intn(keys)
+			if rng.Intn(2) == 0 {
+				ops[i] = txn.MicroOp{F: "r", Key: key}
+				continue
+			}
+			nextValue[key]++
+			ops[i] = txn.MicroOp{F: writeOp, Key: key, Value: nextValue[key]}
+		}
+		return ops
+	}
+
+	load.run(load.clients(nodeIDs), func(process, i int) {
+		ops := generate()
+		result, err := sendTxn(ctx, txnClient, process, randomNode(nodeIDs), ops)
+
+		t := txn.Txn{Process: process, Type: txn.OK, Ops: result}
+		switch {
+		case err == nil:
+		case indeterminate(err):
+			t.Type, t.Ops = txn.Info, ops
+		default:
+			t.Type, t.Ops = txn.Fail, ops
+		}
+		if err != nil {
+			log.Printf("Txn %v by process %d: %s: %v", ops, process, t.Type, err)
+		}
+
+		mu.Lock()
+		txns = append(txns, t)
+		mu.Unlock()
+	})
+
+	var result *txn.Result
+	if writeOp == "append" {
+		result = txn.CheckListAppend(txns)
+	} else {
+		result = txn.CheckRWRegister(txns)
+	}
+
+	committed := 0
+	for _, t := range txns {
+		if t.Type == txn.OK {
+			committed++
+		}
+	}
+	log.Printf("Committed %d of %d transactions", committed, len(txns))
+
+	if !result.Valid {
+		for _, name := range result.Names() {
+			for _, a := range result.Anomalies[name] {
+				log.Printf("%s: %s", name, a)
+			}
+		}
+		fatalf("txn found anomalies: %v", result.Names())
+	}
+	log.Printf("txn found no anomalies")
+}
+
+func sendTxn(ctx context.Context, txnClient txnpb.TxnServiceClient, process int, dest string, ops []txn.MicroOp) ([]txn.MicroOp, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	body := make([]*structpb.ListValue, len(ops))
+	for i, op := range ops {
+		value := structpb.NewNullValue()
+		if v, ok := op.Value.(int); ok {
+			value = structpb.NewNumberValue(float64(v))
+		}
+		body[i] = &structpb.ListValue{Values: []*structpb.Value{
+			structpb.NewStringValue(op.F),
+			structpb.NewNumberValue(float64(op.Key)),
+			value,
+		}}
+	}
+
+	txnReq := &txnpb.TxnRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &txnpb.TxnRequestBody{
+			Type: "txn",
+			Txn:  body,
+		},
+	}
+	invoke := recorder.Invoke(process, "txn", microOpsValue(ops))
+	txnRes, err := txnClient.Txn(ctx, txnReq)
+	if err != nil {
+		complete(invoke, nil, err)
+		return nil, err
+	}
+
+	result := make([]txn.MicroOp, len(txnRes.Body.Txn))
+	for i, l := range txnRes.Body.Txn {
+		op, err := parseMicroOp(l)
+		if err != nil {
+			recorder.Complete(invoke, history.Info, invoke.Value, err)
+			return nil, err
+		}
+		result[i] = op
+	}
+	complete(invoke, microOpsValue(result), nil)
+	return result, nil
+}
+
+// microOpsValue renders ops as [f, key, value] lists for the history.
+func microOpsValue(ops []txn.MicroOp) [][]interface{} {
+	value := make([][]interface{}, len(ops))
+	for i, op := range ops {
+		value[i] = []interface{}{op.F, op.Key, op.Value}
+	}
+	return value
+}
+
+// parseMicroOp converts a [f, key, value] list from a txn_ok reply. Read
+// values come back as null, a number or, for list-append, a list of numbers.
+func parseMicroOp(l *structpb.ListValue) (txn.MicroOp, error) {
+	values := l.GetValues()
+	if len(values) != 3 {
+		return txn.MicroOp{}, fmt.Errorf("micro-op %v is not a [f, key, value] list", l.AsSlice())
+	}
+
+	op := txn.MicroOp{
+		F:   values[0].GetStringValue(),
+		Key: int(values[1].GetNumberValue()),
+	}
+	switch v := values[2].Kind.(type) {
+	case *structpb.Value_NumberValue:
+		op.Value = int(v.NumberValue)
+	case *structpb.Value_ListValue:
+		list := make([]int, len(v.ListValue.Values))
+		for i, e := range v.ListValue.Values {
+			list[i] = int(e.GetNumberValue())
+		}
+		op.Value = list
+	}
+	return op, nil
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Shresth72/go_gRPC_tester/history"
+	uniqueidpb "github.com/Shresth72/go_gRPC_tester/proto/unique_ids"
+)
+
+// generated is one acknowledged generate request.
+type generated struct {
+	id   string
+	dest string
+	op   history.Op
+}
+
+// runUniqueIds sends generate requests from concurrent clients to random
+// nodes and checks that no ID was handed out twice. Duplicates are reported
+// with every operation that returned them.
+func runUniqueIds(ctx context.Context, uniqueIdsClient uniqueidpb.UniqueIdsServiceClient, nodeIDs []string) {
+	var mu sync.Mutex
+	var acked []generated
+
+	count := load.run(load.clients(nodeIDs), func(process, i int) {
+		dest := randomNode(nodeIDs)
+		id, op, err := sendUniqueIdsRequest(ctx, uniqueIdsClient, process, dest)
+		if err != nil {
+			log.Printf("Generate on %s by process %d failed: %v", dest, process, err)
+			return
+		}
+
+		mu.Lock()
+		acked = append(acked, generated{id: id, dest: dest, op: op})
+		mu.Unlock()
+	})
+
+	byID := make(map[string][]generated, len(acked))
+	for _, g := range acked {
+		byID[g.id] = append(byID[g.id], g)
+	}
+
+	var duplicates []string
+	for id, gs := range byID {
+		if len(gs) > 1 {
+			duplicates = append(duplicates, id)
+		}
+	}
+	sort.Strings(duplicates)
+
+	log.Printf("Generated %d IDs (%d distinct) in %d requests", len(acked), len(byID), count)
+	if len(duplicates) > 0 {
+		for _, id := range duplicates {
+			var by []string
+			for _, g := range byID[id] {
+				by = append(by, fmt.Sprintf("process %d via %s (op %d at %s)", g.op.Process, g.dest, g.op.Index, g.op.Time))
+			}
+			log.Printf("unique_ids: %q returned %d times: %s", id, len(by), strings.Join(by, ", "))
+		}
+		fatalf("unique_ids found %d duplicate IDs", len(duplicates))
+	}
+	log.Printf("unique_ids: all %d IDs are unique", len(acked))
+}
+
+// sendUniqueIdsRequest asks dest for an ID and returns it along with the
+// recorded completion.
+func sendUniqueIdsRequest(ctx context.Context, uniqueIdsClient uniqueidpb.UniqueIdsServiceClient, process int, dest string) (string, history.Op, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	uniqueIdsReq := &uniqueidpb.UniqueIdsRequest{
+		Src:  clientID,
+		Dest: dest,
+		Body: &uniqueidpb.UniqueIdsRequestBody{
+			Type: "generate",
+		},
+	}
+
+	op := recorder.Invoke(process, "generate", nil)
+	uniqueIdsRes, err := uniqueIdsClient.SendUniqueIds(ctx, uniqueIdsReq)
+	if err != nil {
+		complete(op, nil, err)
+		return "", history.Op{}, err
+	}
+	return uniqueIdsRes.Body.Id, recorder.Complete(op, history.OK, uniqueIdsRes.Body.Id, nil), nil
+}
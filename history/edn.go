@@ -0,0 +1,138 @@
+package history
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// keyword matches strings that can be written as EDN keywords.
+var keyword = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_.*+!?-]*$`)
+
+// WriteEDN writes ops as EDN maps, one event per line, in the shape Jepsen
+// and Knossos read: {:index 0, :time 0, :type :invoke, :process 0, :f :read,
+// :value nil}. Values are converted through JSON, so anything WriteJSONL can
+// write is written here too.
+func WriteEDN(w io.Writer, ops []Op) error {
+	bw := bufio.NewWriter(w)
+	for _, op := range ops {
+		value, err := ednValue(op.Value)
+		if err != nil {
+			return fmt.Errorf("op %d: %w", op.Index, err)
+		}
+
+		fmt.Fprintf(bw, "{:index %d, :time %d, :type :%s, :process %d, :f %s, :value %s",
+			op.Index, op.Time.Nanoseconds(), op.Type, op.Process, ednName(op.F), value)
+		if op.Error != "" {
+			fmt.Fprintf(bw, ", :error %s", ednString(op.Error))
+		}
+		bw.WriteString("}\n")
+	}
+	return bw.Flush()
+}
+
+// ednValue renders v as EDN by way of its JSON encoding.
+func ednValue(v interface{}) (string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var generic interface{}
+	if err := dec.Decode(&generic); err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	writeEDN(&b, generic)
+	return b.String(), nil
+}
+
+func writeEDN(b *strings.Builder, v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		b.WriteString("nil")
+	case bool:
+		b.WriteString(strconv.FormatBool(v))
+	case json.Number:
+		b.WriteString(v.String())
+	case string:
+		b.WriteString(ednString(v))
+	case []interface{}:
+		b.WriteByte('[')
+		for i, e := range v {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			writeEDN(b, e)
+		}
+		b.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		b.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(ednName(k))
+			b.WriteByte(' ')
+			writeEDN(b, v[k])
+		}
+		b.WriteByte('}')
+	}
+}
+
+// ednName renders s as a keyword when it is a valid one, and as a string
+// otherwise.
+func ednName(s string) string {
+	if keyword.MatchString(s) {
+		return ":" + s
+	}
+	return ednString(s)
+}
+
+// ednString quotes s as an EDN string. Unlike strconv.Quote it only uses
+// the escapes EDN readers accept: quotes, backslashes, newlines, tabs and
+// carriage returns are escaped as such, other control characters as \uXXXX,
+// and everything else, including characters outside the Basic Multilingual
+// Plane, is written as UTF-8. Invalid UTF-8 is written as U+FFFD.
+func ednString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\r':
+			b.WriteString(`\r`)
+		default:
+			if unicode.IsControl(r) {
+				fmt.Fprintf(&b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
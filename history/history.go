@@ -0,0 +1,105 @@
+// Package history records client operations as a Jepsen-style history. Every
+// operation appears twice: once when a process invokes it and once when it
+// completes as ok, fail or info. Checkers and post-mortems work from the
+// recorded history rather than from the tester's logs.
+package history
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+)
+
+// Type is the kind of event an Op records.
+type Type string
+
+const (
+	// Invoke is the start of an operation.
+	Invoke Type = "invoke"
+	// OK operations definitely took effect.
+	OK Type = "ok"
+	// Fail operations definitely did not take effect.
+	Fail Type = "fail"
+	// Info operations have an unknown outcome, such as a timeout.
+	Info Type = "info"
+)
+
+// Op is one event in a history. Time is measured from when the recorder was
+// created, using the monotonic clock.
+type Op struct {
+	Index   int           `json:"index"`
+	Time    time.Duration `json:"time"`
+	Type    Type          `json:"type"`
+	Process int           `json:"process"`
+	F       string        `json:"f"`
+	Value   interface{}   `json:"value"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// Recorder collects the events of a history. It is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	start time.Time
+	ops   []Op
+}
+
+// NewRecorder returns an empty recorder whose clock starts now.
+func NewRecorder() *Recorder {
+	return &Recorder{start: time.Now()}
+}
+
+func (r *Recorder) add(op Op) Op {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	op.Index = len(r.ops)
+	op.Time = time.Since(r.start)
+	r.ops = append(r.ops, op)
+	return op
+}
+
+// Invoke records process starting f with value and returns the event, which
+// is later passed to Complete.
+func (r *Recorder) Invoke(process int, f string, value interface{}) Op {
+	return r.add(Op{Type: Invoke, Process: process, F: f, Value: value})
+}
+
+// Complete records the outcome of an invocation. err, if not nil, is kept as
+// the event's error text.
+func (r *Recorder) Complete(invoke Op, t Type, value interface{}, err error) Op {
+	op := Op{Type: t, Process: invoke.Process, F: invoke.F, Value: value}
+	if err != nil {
+		op.Error = err.Error()
+	}
+	return r.add(op)
+}
+
+// Len returns the number of events recorded so far, which is also the index
+// the next event will get.
+func (r *Recorder) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.ops)
+}
+
+// Ops returns a copy of the events recorded so far, in order.
+func (r *Recorder) Ops() []Op {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Op(nil), r.ops...)
+}
+
+// WriteJSONL writes ops as JSON Lines, one event per line. Times are in
+// nanoseconds.
+func WriteJSONL(w io.Writer, ops []Op) error {
+	enc := json.NewEncoder(w)
+	for _, op := range ops {
+		if err := enc.Encode(op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
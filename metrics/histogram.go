@@ -0,0 +1,127 @@
+// Package metrics records latency distributions for the tester's reports.
+package metrics
+
+import (
+	"math/bits"
+	"time"
+)
+
+// subBits sets the histogram's precision: every bucket is at most 1/2^subBits
+// of its value wide, so recorded values keep two significant digits.
+const subBits = 7
+
+// Histogram counts durations in log-linear buckets, as HdrHistogram does.
+// Values below 2^subBits nanoseconds are exact, and larger ones are kept to
+// within 1/2^subBits of their value, in constant memory per power of two.
+// The zero value is empty and ready to use; it is not safe for concurrent use.
+type Histogram struct {
+	counts   []int64
+	count    int64
+	sum      time.Duration
+	min, max time.Duration
+}
+
+// bucket returns the index of the bucket holding v nanoseconds.
+func bucket(v int64) int {
+	if v < 1<<subBits {
+		return int(v)
+	}
+	exp := bits.Len64(uint64(v)) - subBits - 1
+	sub := v >> exp
+	return (exp+1)<<subBits + int(sub-1<<subBits)
+}
+
+// bucketHigh returns the largest value that falls in bucket b.
+func bucketHigh(b int) int64 {
+	if b < 1<<subBits {
+		return int64(b)
+	}
+	exp := b>>subBits - 1
+	sub := int64(b&(1<<subBits-1)) + 1<<subBits
+	return (sub+1)<<exp - 1
+}
+
+// Record adds one observation. Negative durations count as zero.
+func (h *Histogram) Record(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+
+	b := bucket(int64(d))
+	if b >= len(h.counts) {
+		h.counts = append(h.counts, make([]int64, b+1-len(h.counts))...)
+	}
+	h.counts[b]++
+
+	if h.count == 0 || d < h.min {
+		h.min = d
+	}
+	if d > h.max {
+		h.max = d
+	}
+	h.count++
+	h.sum += d
+}
+
+// Merge adds every observation in o to h.
+func (h *Histogram) Merge(o *Histogram) {
+	if o.count == 0 {
+		return
+	}
+	if len(o.counts) > len(h.counts) {
+		h.counts = append(h.counts, make([]int64, len(o.counts)-len(h.counts))...)
+	}
+	for b, n := range o.counts {
+		h.counts[b] += n
+	}
+
+	if h.count == 0 || o.min < h.min {
+		h.min = o.min
+	}
+	if o.max > h.max {
+		h.max = o.max
+	}
+	h.count += o.count
+	h.sum += o.sum
+}
+
+// Count returns the number of observations.
+func (h *Histogram) Count() int64 { return h.count }
+
+// Min returns the smallest observation.
+func (h *Histogram) Min() time.Duration { return h.min }
+
+// Max returns the largest observation.
+func (h *Histogram) Max() time.Duration { return h.max }
+
+// Mean returns the average observation.
+func (h *Histogram) Mean() time.Duration {
+	if h.count == 0 {
+		return 0
+	}
+	return h.sum / time.Duration(h.count)
+}
+
+// Quantile returns the value at quantile q, 0 <= q <= 1: the upper end of the
+// bucket holding that rank, capped at the largest observation.
+func (h *Histogram) Quantile(q float64) time.Duration {
+	if h.count == 0 {
+		return 0
+	}
+
+	rank := int64(q*float64(h.count) + 0.5)
+	if rank < 1 {
+		rank = 1
+	}
+	var seen int64
+	for b, n := range h.counts {
+		seen += n
+		if seen >= rank {
+			if v := time.Duration(bucketHigh(b)); v < h.max {
+				return v
+			}
+			return h.max
+		}
+	}
+	return h.max
+}
@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: proto/counter/counter.proto
+
+package counter
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	CounterService_SendCounterAdd_FullMethodName  = "/myservice.counter.CounterService/SendCounterAdd"
+	CounterService_SendCounterRead_FullMethodName = "/myservice.counter.CounterService/SendCounterRead"
+)
+
+// CounterServiceClient is the client API for CounterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CounterServiceClient interface {
+	SendCounterAdd(ctx context.Context, in *CounterAddRequest, opts ...grpc.CallOption) (*CounterAddResponse, error)
+	SendCounterRead(ctx context.Context, in *CounterReadRequest, opts ...grpc.CallOption) (*CounterReadResponse, error)
+}
+
+type counterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCounterServiceClient(cc grpc.ClientConnInterface) CounterServiceClient {
+	return &counterServiceClient{cc}
+}
+
+func (c *counterServiceClient) SendCounterAdd(ctx context.Context, in *CounterAddRequest, opts ...grpc.CallOption) (*CounterAddResponse, error) {
+	out := new(CounterAddResponse)
+	err := c.cc.Invoke(ctx, CounterService_SendCounterAdd_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *counterServiceClient) SendCounterRead(ctx context.Context, in *CounterReadRequest, opts ...grpc.CallOption) (*CounterReadResponse, error) {
+	out := new(CounterReadResponse)
+	err := c.cc.Invoke(ctx, CounterService_SendCounterRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CounterServiceServer is the server API for CounterService service.
+// All implementations must embed UnimplementedCounterServiceServer
+// for forward compatibility
+type CounterServiceServer interface {
+	SendCounterAdd(context.Context, *CounterAddRequest) (*CounterAddResponse, error)
+	SendCounterRead(context.Context, *CounterReadRequest) (*CounterReadResponse, error)
+	mustEmbedUnimplementedCounterServiceServer()
+}
+
+// UnimplementedCounterServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCounterServiceServer struct {
+}
+
+func (UnimplementedCounterServiceServer) SendCounterAdd(context.Context, *CounterAddRequest) (*CounterAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCounterAdd not implemented")
+}
+func (UnimplementedCounterServiceServer) SendCounterRead(context.Context, *CounterReadRequest) (*CounterReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCounterRead not implemented")
+}
+func (UnimplementedCounterServiceServer) mustEmbedUnimplementedCounterServiceServer() {}
+
+// UnsafeCounterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CounterServiceServer will
+// result in compilation errors.
+type UnsafeCounterServiceServer interface {
+	mustEmbedUnimplementedCounterServiceServer()
+}
+
+func RegisterCounterServiceServer(s grpc.ServiceRegistrar, srv CounterServiceServer) {
+	s.RegisterService(&CounterService_ServiceDesc, srv)
+}
+
+func _CounterService_SendCounterAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterServiceServer).SendCounterAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CounterService_SendCounterAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterServiceServer).SendCounterAdd(ctx, req.(*CounterAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CounterService_SendCounterRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CounterServiceServer).SendCounterRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CounterService_SendCounterRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CounterServiceServer).SendCounterRead(ctx, req.(*CounterReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CounterService_ServiceDesc is the grpc.ServiceDesc for CounterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CounterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "myservice.counter.CounterService",
+	HandlerType: (*CounterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendCounterAdd",
+			Handler:    _CounterService_SendCounterAdd_Handler,
+		},
+		{
+			MethodName: "SendCounterRead",
+			Handler:    _CounterService_SendCounterRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/counter/counter.proto",
+}
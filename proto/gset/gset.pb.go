@@ -0,0 +1,743 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.0
+// 	protoc        v5.26.1
+// source: proto/gset/gset.proto
+
+package gset
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Add RPC
+type GSetAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Src  string              `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dest string              `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	Body *GSetAddRequestBody `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *GSetAddRequest) Reset() {
+	*x = GSetAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_gset_gset_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GSetAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GSetAddRequest) ProtoMessage() {}
+
+func (x *GSetAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gset_gset_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GSetAddRequest.ProtoReflect.Descriptor instead.
+func (*GSetAddRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gset_gset_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GSetAddRequest) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *GSetAddRequest) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *GSetAddRequest) GetBody() *GSetAddRequestBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type GSetAddRequestBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type    string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Element int32  `protobuf:"varint,2,opt,name=element,proto3" json:"element,omitempty"`
+}
+
+func (x *GSetAddRequestBody) Reset() {
+	*x = GSetAddRequestBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_gset_gset_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GSetAddRequestBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GSetAddRequestBody) ProtoMessage() {}
+
+func (x *GSetAddRequestBody) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gset_gset_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GSetAddRequestBody.ProtoReflect.Descriptor instead.
+func (*GSetAddRequestBody) Descriptor() ([]byte, []int) {
+	return file_proto_gset_gset_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GSetAddRequestBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GSetAddRequestBody) GetElement() int32 {
+	if x != nil {
+		return x.Element
+	}
+	return 0
+}
+
+type GSetAddResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Src  string               `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dest string               `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	Body *GSetAddResponseBody `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *GSetAddResponse) Reset() {
+	*x = GSetAddResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_gset_gset_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GSetAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GSetAddResponse) ProtoMessage() {}
+
+func (x *GSetAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gset_gset_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GSetAddResponse.ProtoReflect.Descriptor instead.
+func (*GSetAddResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gset_gset_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GSetAddResponse) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *GSetAddResponse) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *GSetAddResponse) GetBody() *GSetAddResponseBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type GSetAddResponseBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	MsgId     int32  `protobuf:"varint,2,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	InReplyTo int32  `protobuf:"varint,3,opt,name=in_reply_to,json=inReplyTo,proto3" json:"in_reply_to,omitempty"`
+}
+
+func (x *GSetAddResponseBody) Reset() {
+	*x = GSetAddResponseBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_gset_gset_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GSetAddResponseBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GSetAddResponseBody) ProtoMessage() {}
+
+func (x *GSetAddResponseBody) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gset_gset_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GSetAddResponseBody.ProtoReflect.Descriptor instead.
+func (*GSetAddResponseBody) Descriptor() ([]byte, []int) {
+	return file_proto_gset_gset_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GSetAddResponseBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GSetAddResponseBody) GetMsgId() int32 {
+	if x != nil {
+		return x.MsgId
+	}
+	return 0
+}
+
+func (x *GSetAddResponseBody) GetInReplyTo() int32 {
+	if x != nil {
+		return x.InReplyTo
+	}
+	return 0
+}
+
+// Read RPC
+type GSetReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Src  string               `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dest string               `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	Body *GSetReadRequestBody `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *GSetReadRequest) Reset() {
+	*x = GSetReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_gset_gset_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GSetReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GSetReadRequest) ProtoMessage() {}
+
+func (x *GSetReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gset_gset_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GSetReadRequest.ProtoReflect.Descriptor instead.
+func (*GSetReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_gset_gset_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GSetReadRequest) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *GSetReadRequest) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *GSetReadRequest) GetBody() *GSetReadRequestBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type GSetReadRequestBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *GSetReadRequestBody) Reset() {
+	*x = GSetReadRequestBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_gset_gset_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GSetReadRequestBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GSetReadRequestBody) ProtoMessage() {}
+
+func (x *GSetReadRequestBody) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gset_gset_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GSetReadRequestBody.ProtoReflect.Descriptor instead.
+func (*GSetReadRequestBody) Descriptor() ([]byte, []int) {
+	return file_proto_gset_gset_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GSetReadRequestBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type GSetReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Src  string                `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dest string                `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	Body *GSetReadResponseBody `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *GSetReadResponse) Reset() {
+	*x = GSetReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_gset_gset_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GSetReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GSetReadResponse) ProtoMessage() {}
+
+func (x *GSetReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gset_gset_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GSetReadResponse.ProtoReflect.Descriptor instead.
+func (*GSetReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_gset_gset_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GSetReadResponse) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *GSetReadResponse) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *GSetReadResponse) GetBody() *GSetReadResponseBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type GSetReadResponseBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value     []int32 `protobuf:"varint,2,rep,packed,name=value,proto3" json:"value,omitempty"`
+	MsgId     int32   `protobuf:"varint,3,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	InReplyTo int32   `protobuf:"varint,4,opt,name=in_reply_to,json=inReplyTo,proto3" json:"in_reply_to,omitempty"`
+}
+
+func (x *GSetReadResponseBody) Reset() {
+	*x = GSetReadResponseBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_gset_gset_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GSetReadResponseBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GSetReadResponseBody) ProtoMessage() {}
+
+func (x *GSetReadResponseBody) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_gset_gset_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GSetReadResponseBody.ProtoReflect.Descriptor instead.
+func (*GSetReadResponseBody) Descriptor() ([]byte, []int) {
+	return file_proto_gset_gset_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GSetReadResponseBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GSetReadResponseBody) GetValue() []int32 {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *GSetReadResponseBody) GetMsgId() int32 {
+	if x != nil {
+		return x.MsgId
+	}
+	return 0
+}
+
+func (x *GSetReadResponseBody) GetInReplyTo() int32 {
+	if x != nil {
+		return x.InReplyTo
+	}
+	return 0
+}
+
+var File_proto_gset_gset_proto protoreflect.FileDescriptor
+
+var file_proto_gset_gset_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x73, 0x65, 0x74, 0x2f, 0x67, 0x73, 0x65,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x67, 0x73, 0x65, 0x74, 0x22, 0x6e, 0x0a, 0x0e, 0x47, 0x53, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12,
+	0x36, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x67, 0x73, 0x65, 0x74, 0x2e, 0x47,
+	0x53, 0x65, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64,
+	0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x42, 0x0a, 0x12, 0x47, 0x53, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x70, 0x0a, 0x0f, 0x47,
+	0x53, 0x65, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x67,
+	0x73, 0x65, 0x74, 0x2e, 0x47, 0x53, 0x65, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x60, 0x0a,
+	0x13, 0x47, 0x53, 0x65, 0x74, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0b, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x22,
+	0x70, 0x0a, 0x0f, 0x47, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x72, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x67, 0x73, 0x65, 0x74, 0x2e, 0x47, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x22, 0x29, 0x0a, 0x13, 0x47, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x72, 0x0a, 0x10,
+	0x47, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x72, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x67, 0x73, 0x65, 0x74, 0x2e, 0x47, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x22, 0x77, 0x0a, 0x14, 0x47, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x6e, 0x5f,
+	0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x32, 0xb0, 0x01, 0x0a, 0x0b, 0x47, 0x53,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x53, 0x65, 0x6e,
+	0x64, 0x47, 0x53, 0x65, 0x74, 0x41, 0x64, 0x64, 0x12, 0x1e, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x67, 0x73, 0x65, 0x74, 0x2e, 0x47, 0x53, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x67, 0x73, 0x65, 0x74, 0x2e, 0x47, 0x53, 0x65, 0x74, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0c, 0x53, 0x65, 0x6e,
+	0x64, 0x47, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1f, 0x2e, 0x6d, 0x79, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x67, 0x73, 0x65, 0x74, 0x2e, 0x47, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x79, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x67, 0x73, 0x65, 0x74, 0x2e, 0x47, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0c, 0x5a, 0x0a,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x73, 0x65, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_proto_gset_gset_proto_rawDescOnce sync.Once
+	file_proto_gset_gset_proto_rawDescData = file_proto_gset_gset_proto_rawDesc
+)
+
+func file_proto_gset_gset_proto_rawDescGZIP() []byte {
+	file_proto_gset_gset_proto_rawDescOnce.Do(func() {
+		file_proto_gset_gset_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_gset_gset_proto_rawDescData)
+	})
+	return file_proto_gset_gset_proto_rawDescData
+}
+
+var file_proto_gset_gset_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_proto_gset_gset_proto_goTypes = []interface{}{
+	(*GSetAddRequest)(nil),       // 0: myservice.gset.GSetAddRequest
+	(*GSetAddRequestBody)(nil),   // 1: myservice.gset.GSetAddRequestBody
+	(*GSetAddResponse)(nil),      // 2: myservice.gset.GSetAddResponse
+	(*GSetAddResponseBody)(nil),  // 3: myservice.gset.GSetAddResponseBody
+	(*GSetReadRequest)(nil),      // 4: myservice.gset.GSetReadRequest
+	(*GSetReadRequestBody)(nil),  // 5: myservice.gset.GSetReadRequestBody
+	(*GSetReadResponse)(nil),     // 6: myservice.gset.GSetReadResponse
+	(*GSetReadResponseBody)(nil), // 7: myservice.gset.GSetReadResponseBody
+}
+var file_proto_gset_gset_proto_depIdxs = []int32{
+	1, // 0: myservice.gset.GSetAddRequest.body:type_name -> myservice.gset.GSetAddRequestBody
+	3, // 1: myservice.gset.GSetAddResponse.body:type_name -> myservice.gset.GSetAddResponseBody
+	5, // 2: myservice.gset.GSetReadRequest.body:type_name -> myservice.gset.GSetReadRequestBody
+	7, // 3: myservice.gset.GSetReadResponse.body:type_name -> myservice.gset.GSetReadResponseBody
+	0, // 4: myservice.gset.GSetService.SendGSetAdd:input_type -> myservice.gset.GSetAddRequest
+	4, // 5: myservice.gset.GSetService.SendGSetRead:input_type -> myservice.gset.GSetReadRequest
+	2, // 6: myservice.gset.GSetService.SendGSetAdd:output_type -> myservice.gset.GSetAddResponse
+	6, // 7: myservice.gset.GSetService.SendGSetRead:output_type -> myservice.gset.GSetReadResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_gset_gset_proto_init() }
+func file_proto_gset_gset_proto_init() {
+	if File_proto_gset_gset_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_gset_gset_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GSetAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_gset_gset_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GSetAddRequestBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_gset_gset_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GSetAddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_gset_gset_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GSetAddResponseBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_gset_gset_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GSetReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_gset_gset_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GSetReadRequestBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_gset_gset_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GSetReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_gset_gset_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GSetReadResponseBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_gset_gset_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_gset_gset_proto_goTypes,
+		DependencyIndexes: file_proto_gset_gset_proto_depIdxs,
+		MessageInfos:      file_proto_gset_gset_proto_msgTypes,
+	}.Build()
+	File_proto_gset_gset_proto = out.File
+	file_proto_gset_gset_proto_rawDesc = nil
+	file_proto_gset_gset_proto_goTypes = nil
+	file_proto_gset_gset_proto_depIdxs = nil
+}
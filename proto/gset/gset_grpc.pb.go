@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: proto/gset/gset.proto
+
+package gset
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	GSetService_SendGSetAdd_FullMethodName  = "/myservice.gset.GSetService/SendGSetAdd"
+	GSetService_SendGSetRead_FullMethodName = "/myservice.gset.GSetService/SendGSetRead"
+)
+
+// GSetServiceClient is the client API for GSetService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GSetServiceClient interface {
+	SendGSetAdd(ctx context.Context, in *GSetAddRequest, opts ...grpc.CallOption) (*GSetAddResponse, error)
+	SendGSetRead(ctx context.Context, in *GSetReadRequest, opts ...grpc.CallOption) (*GSetReadResponse, error)
+}
+
+type gSetServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGSetServiceClient(cc grpc.ClientConnInterface) GSetServiceClient {
+	return &gSetServiceClient{cc}
+}
+
+func (c *gSetServiceClient) SendGSetAdd(ctx context.Context, in *GSetAddRequest, opts ...grpc.CallOption) (*GSetAddResponse, error) {
+	out := new(GSetAddResponse)
+	err := c.cc.Invoke(ctx, GSetService_SendGSetAdd_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gSetServiceClient) SendGSetRead(ctx context.Context, in *GSetReadRequest, opts ...grpc.CallOption) (*GSetReadResponse, error) {
+	out := new(GSetReadResponse)
+	err := c.cc.Invoke(ctx, GSetService_SendGSetRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GSetServiceServer is the server API for GSetService service.
+// All implementations must embed UnimplementedGSetServiceServer
+// for forward compatibility
+type GSetServiceServer interface {
+	SendGSetAdd(context.Context, *GSetAddRequest) (*GSetAddResponse, error)
+	SendGSetRead(context.Context, *GSetReadRequest) (*GSetReadResponse, error)
+	mustEmbedUnimplementedGSetServiceServer()
+}
+
+// UnimplementedGSetServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGSetServiceServer struct {
+}
+
+func (UnimplementedGSetServiceServer) SendGSetAdd(context.Context, *GSetAddRequest) (*GSetAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendGSetAdd not implemented")
+}
+func (UnimplementedGSetServiceServer) SendGSetRead(context.Context, *GSetReadRequest) (*GSetReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendGSetRead not implemented")
+}
+func (UnimplementedGSetServiceServer) mustEmbedUnimplementedGSetServiceServer() {}
+
+// UnsafeGSetServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GSetServiceServer will
+// result in compilation errors.
+type UnsafeGSetServiceServer interface {
+	mustEmbedUnimplementedGSetServiceServer()
+}
+
+func RegisterGSetServiceServer(s grpc.ServiceRegistrar, srv GSetServiceServer) {
+	s.RegisterService(&GSetService_ServiceDesc, srv)
+}
+
+func _GSetService_SendGSetAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GSetAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GSetServiceServer).SendGSetAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GSetService_SendGSetAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GSetServiceServer).SendGSetAdd(ctx, req.(*GSetAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GSetService_SendGSetRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GSetReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GSetServiceServer).SendGSetRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GSetService_SendGSetRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GSetServiceServer).SendGSetRead(ctx, req.(*GSetReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GSetService_ServiceDesc is the grpc.ServiceDesc for GSetService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GSetService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "myservice.gset.GSetService",
+	HandlerType: (*GSetServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendGSetAdd",
+			Handler:    _GSetService_SendGSetAdd_Handler,
+		},
+		{
+			MethodName: "SendGSetRead",
+			Handler:    _GSetService_SendGSetRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/gset/gset.proto",
+}
@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	InitService_SendInit_FullMethodName      = "/myservice.init.InitService/SendInit"
 	InitService_SetBinaryName_FullMethodName = "/myservice.init.InitService/SetBinaryName"
+	InitService_Launch_FullMethodName        = "/myservice.init.InitService/Launch"
 )
 
 // InitServiceClient is the client API for InitService service.
@@ -29,6 +30,7 @@ const (
 type InitServiceClient interface {
 	SendInit(ctx context.Context, in *InitRequest, opts ...grpc.CallOption) (*InitResponse, error)
 	SetBinaryName(ctx context.Context, in *SetBinaryNameRequest, opts ...grpc.CallOption) (*SetBinaryNameResponse, error)
+	Launch(ctx context.Context, in *LaunchRequest, opts ...grpc.CallOption) (*LaunchResponse, error)
 }
 
 type initServiceClient struct {
@@ -57,12 +59,22 @@ func (c *initServiceClient) SetBinaryName(ctx context.Context, in *SetBinaryName
 	return out, nil
 }
 
+func (c *initServiceClient) Launch(ctx context.Context, in *LaunchRequest, opts ...grpc.CallOption) (*LaunchResponse, error) {
+	out := new(LaunchResponse)
+	err := c.cc.Invoke(ctx, InitService_Launch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InitServiceServer is the server API for InitService service.
 // All implementations must embed UnimplementedInitServiceServer
 // for forward compatibility
 type InitServiceServer interface {
 	SendInit(context.Context, *InitRequest) (*InitResponse, error)
 	SetBinaryName(context.Context, *SetBinaryNameRequest) (*SetBinaryNameResponse, error)
+	Launch(context.Context, *LaunchRequest) (*LaunchResponse, error)
 	mustEmbedUnimplementedInitServiceServer()
 }
 
@@ -76,6 +88,9 @@ func (UnimplementedInitServiceServer) SendInit(context.Context, *InitRequest) (*
 func (UnimplementedInitServiceServer) SetBinaryName(context.Context, *SetBinaryNameRequest) (*SetBinaryNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetBinaryName not implemented")
 }
+func (UnimplementedInitServiceServer) Launch(context.Context, *LaunchRequest) (*LaunchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Launch not implemented")
+}
 func (UnimplementedInitServiceServer) mustEmbedUnimplementedInitServiceServer() {}
 
 // UnsafeInitServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -125,6 +140,24 @@ func _InitService_SetBinaryName_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InitService_Launch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LaunchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InitServiceServer).Launch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InitService_Launch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InitServiceServer).Launch(ctx, req.(*LaunchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InitService_ServiceDesc is the grpc.ServiceDesc for InitService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -140,6 +173,10 @@ var InitService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetBinaryName",
 			Handler:    _InitService_SetBinaryName_Handler,
 		},
+		{
+			MethodName: "Launch",
+			Handler:    _InitService_Launch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/init/init.proto",
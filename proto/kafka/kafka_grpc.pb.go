@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: proto/kafka/kafka.proto
+
+package kafka
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	KafkaService_Send_FullMethodName                 = "/myservice.kafka.KafkaService/Send"
+	KafkaService_Poll_FullMethodName                 = "/myservice.kafka.KafkaService/Poll"
+	KafkaService_CommitOffsets_FullMethodName        = "/myservice.kafka.KafkaService/CommitOffsets"
+	KafkaService_ListCommittedOffsets_FullMethodName = "/myservice.kafka.KafkaService/ListCommittedOffsets"
+)
+
+// KafkaServiceClient is the client API for KafkaService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KafkaServiceClient interface {
+	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error)
+	Poll(ctx context.Context, in *PollRequest, opts ...grpc.CallOption) (*PollResponse, error)
+	CommitOffsets(ctx context.Context, in *CommitOffsetsRequest, opts ...grpc.CallOption) (*CommitOffsetsResponse, error)
+	ListCommittedOffsets(ctx context.Context, in *ListCommittedOffsetsRequest, opts ...grpc.CallOption) (*ListCommittedOffsetsResponse, error)
+}
+
+type kafkaServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKafkaServiceClient(cc grpc.ClientConnInterface) KafkaServiceClient {
+	return &kafkaServiceClient{cc}
+}
+
+func (c *kafkaServiceClient) Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error) {
+	out := new(SendResponse)
+	err := c.cc.Invoke(ctx, KafkaService_Send_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaServiceClient) Poll(ctx context.Context, in *PollRequest, opts ...grpc.CallOption) (*PollResponse, error) {
+	out := new(PollResponse)
+	err := c.cc.Invoke(ctx, KafkaService_Poll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaServiceClient) CommitOffsets(ctx context.Context, in *CommitOffsetsRequest, opts ...grpc.CallOption) (*CommitOffsetsResponse, error) {
+	out := new(CommitOffsetsResponse)
+	err := c.cc.Invoke(ctx, KafkaService_CommitOffsets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kafkaServiceClient) ListCommittedOffsets(ctx context.Context, in *ListCommittedOffsetsRequest, opts ...grpc.CallOption) (*ListCommittedOffsetsResponse, error) {
+	out := new(ListCommittedOffsetsResponse)
+	err := c.cc.Invoke(ctx, KafkaService_ListCommittedOffsets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KafkaServiceServer is the server API for KafkaService service.
+// All implementations must embed UnimplementedKafkaServiceServer
+// for forward compatibility
+type KafkaServiceServer interface {
+	Send(context.Context, *SendRequest) (*SendResponse, error)
+	Poll(context.Context, *PollRequest) (*PollResponse, error)
+	CommitOffsets(context.Context, *CommitOffsetsRequest) (*CommitOffsetsResponse, error)
+	ListCommittedOffsets(context.Context, *ListCommittedOffsetsRequest) (*ListCommittedOffsetsResponse, error)
+	mustEmbedUnimplementedKafkaServiceServer()
+}
+
+// UnimplementedKafkaServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedKafkaServiceServer struct {
+}
+
+func (UnimplementedKafkaServiceServer) Send(context.Context, *SendRequest) (*SendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
+}
+func (UnimplementedKafkaServiceServer) Poll(context.Context, *PollRequest) (*PollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Poll not implemented")
+}
+func (UnimplementedKafkaServiceServer) CommitOffsets(context.Context, *CommitOffsetsRequest) (*CommitOffsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitOffsets not implemented")
+}
+func (UnimplementedKafkaServiceServer) ListCommittedOffsets(context.Context, *ListCommittedOffsetsRequest) (*ListCommittedOffsetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommittedOffsets not implemented")
+}
+func (UnimplementedKafkaServiceServer) mustEmbedUnimplementedKafkaServiceServer() {}
+
+// UnsafeKafkaServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KafkaServiceServer will
+// result in compilation errors.
+type UnsafeKafkaServiceServer interface {
+	mustEmbedUnimplementedKafkaServiceServer()
+}
+
+func RegisterKafkaServiceServer(s grpc.ServiceRegistrar, srv KafkaServiceServer) {
+	s.RegisterService(&KafkaService_ServiceDesc, srv)
+}
+
+func _KafkaService_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaServiceServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaService_Send_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaServiceServer).Send(ctx, req.(*SendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaService_Poll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaServiceServer).Poll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaService_Poll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaServiceServer).Poll(ctx, req.(*PollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaService_CommitOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaServiceServer).CommitOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaService_CommitOffsets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaServiceServer).CommitOffsets(ctx, req.(*CommitOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KafkaService_ListCommittedOffsets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommittedOffsetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KafkaServiceServer).ListCommittedOffsets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KafkaService_ListCommittedOffsets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KafkaServiceServer).ListCommittedOffsets(ctx, req.(*ListCommittedOffsetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KafkaService_ServiceDesc is the grpc.ServiceDesc for KafkaService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KafkaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "myservice.kafka.KafkaService",
+	HandlerType: (*KafkaServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Send",
+			Handler:    _KafkaService_Send_Handler,
+		},
+		{
+			MethodName: "Poll",
+			Handler:    _KafkaService_Poll_Handler,
+		},
+		{
+			MethodName: "CommitOffsets",
+			Handler:    _KafkaService_CommitOffsets_Handler,
+		},
+		{
+			MethodName: "ListCommittedOffsets",
+			Handler:    _KafkaService_ListCommittedOffsets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/kafka/kafka.proto",
+}
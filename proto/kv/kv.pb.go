@@ -0,0 +1,1104 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.0
+// 	protoc        v5.26.1
+// source: proto/kv/kv.proto
+
+package kv
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Read RPC
+type KVReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Src  string             `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dest string             `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	Body *KVReadRequestBody `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *KVReadRequest) Reset() {
+	*x = KVReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_kv_kv_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVReadRequest) ProtoMessage() {}
+
+func (x *KVReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_kv_kv_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVReadRequest.ProtoReflect.Descriptor instead.
+func (*KVReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_kv_kv_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KVReadRequest) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *KVReadRequest) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *KVReadRequest) GetBody() *KVReadRequestBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type KVReadRequestBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Key  int32  `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *KVReadRequestBody) Reset() {
+	*x = KVReadRequestBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_kv_kv_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVReadRequestBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVReadRequestBody) ProtoMessage() {}
+
+func (x *KVReadRequestBody) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_kv_kv_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVReadRequestBody.ProtoReflect.Descriptor instead.
+func (*KVReadRequestBody) Descriptor() ([]byte, []int) {
+	return file_proto_kv_kv_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KVReadRequestBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *KVReadRequestBody) GetKey() int32 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+type KVReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Src  string              `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dest string              `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	Body *KVReadResponseBody `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *KVReadResponse) Reset() {
+	*x = KVReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_kv_kv_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVReadResponse) ProtoMessage() {}
+
+func (x *KVReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_kv_kv_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVReadResponse.ProtoReflect.Descriptor instead.
+func (*KVReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_kv_kv_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KVReadResponse) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *KVReadResponse) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *KVReadResponse) GetBody() *KVReadResponseBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type KVReadResponseBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value     int32  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	MsgId     int32  `protobuf:"varint,3,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	InReplyTo int32  `protobuf:"varint,4,opt,name=in_reply_to,json=inReplyTo,proto3" json:"in_reply_to,omitempty"`
+}
+
+func (x *KVReadResponseBody) Reset() {
+	*x = KVReadResponseBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_kv_kv_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVReadResponseBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVReadResponseBody) ProtoMessage() {}
+
+func (x *KVReadResponseBody) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_kv_kv_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVReadResponseBody.ProtoReflect.Descriptor instead.
+func (*KVReadResponseBody) Descriptor() ([]byte, []int) {
+	return file_proto_kv_kv_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KVReadResponseBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *KVReadResponseBody) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *KVReadResponseBody) GetMsgId() int32 {
+	if x != nil {
+		return x.MsgId
+	}
+	return 0
+}
+
+func (x *KVReadResponseBody) GetInReplyTo() int32 {
+	if x != nil {
+		return x.InReplyTo
+	}
+	return 0
+}
+
+// Write RPC
+type KVWriteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Src  string              `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dest string              `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	Body *KVWriteRequestBody `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *KVWriteRequest) Reset() {
+	*x = KVWriteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_kv_kv_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVWriteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVWriteRequest) ProtoMessage() {}
+
+func (x *KVWriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_kv_kv_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVWriteRequest.ProtoReflect.Descriptor instead.
+func (*KVWriteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_kv_kv_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KVWriteRequest) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *KVWriteRequest) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *KVWriteRequest) GetBody() *KVWriteRequestBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type KVWriteRequestBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Key   int32  `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value int32  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *KVWriteRequestBody) Reset() {
+	*x = KVWriteRequestBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_kv_kv_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVWriteRequestBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVWriteRequestBody) ProtoMessage() {}
+
+func (x *KVWriteRequestBody) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_kv_kv_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVWriteRequestBody.ProtoReflect.Descriptor instead.
+func (*KVWriteRequestBody) Descriptor() ([]byte, []int) {
+	return file_proto_kv_kv_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *KVWriteRequestBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *KVWriteRequestBody) GetKey() int32 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+func (x *KVWriteRequestBody) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type KVWriteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Src  string               `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dest string               `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	Body *KVWriteResponseBody `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *KVWriteResponse) Reset() {
+	*x = KVWriteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_kv_kv_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVWriteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVWriteResponse) ProtoMessage() {}
+
+func (x *KVWriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_kv_kv_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVWriteResponse.ProtoReflect.Descriptor instead.
+func (*KVWriteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_kv_kv_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *KVWriteResponse) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *KVWriteResponse) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *KVWriteResponse) GetBody() *KVWriteResponseBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type KVWriteResponseBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	MsgId     int32  `protobuf:"varint,2,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	InReplyTo int32  `protobuf:"varint,3,opt,name=in_reply_to,json=inReplyTo,proto3" json:"in_reply_to,omitempty"`
+}
+
+func (x *KVWriteResponseBody) Reset() {
+	*x = KVWriteResponseBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_kv_kv_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVWriteResponseBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVWriteResponseBody) ProtoMessage() {}
+
+func (x *KVWriteResponseBody) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_kv_kv_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVWriteResponseBody.ProtoReflect.Descriptor instead.
+func (*KVWriteResponseBody) Descriptor() ([]byte, []int) {
+	return file_proto_kv_kv_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *KVWriteResponseBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *KVWriteResponseBody) GetMsgId() int32 {
+	if x != nil {
+		return x.MsgId
+	}
+	return 0
+}
+
+func (x *KVWriteResponseBody) GetInReplyTo() int32 {
+	if x != nil {
+		return x.InReplyTo
+	}
+	return 0
+}
+
+// Cas RPC
+type KVCasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Src  string            `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dest string            `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	Body *KVCasRequestBody `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *KVCasRequest) Reset() {
+	*x = KVCasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_kv_kv_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVCasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVCasRequest) ProtoMessage() {}
+
+func (x *KVCasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_kv_kv_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVCasRequest.ProtoReflect.Descriptor instead.
+func (*KVCasRequest) Descriptor() ([]byte, []int) {
+	return file_proto_kv_kv_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KVCasRequest) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *KVCasRequest) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *KVCasRequest) GetBody() *KVCasRequestBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type KVCasRequestBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Key  int32  `protobuf:"varint,2,opt,name=key,proto3" json:"key,omitempty"`
+	From int32  `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   int32  `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *KVCasRequestBody) Reset() {
+	*x = KVCasRequestBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_kv_kv_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVCasRequestBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVCasRequestBody) ProtoMessage() {}
+
+func (x *KVCasRequestBody) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_kv_kv_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVCasRequestBody.ProtoReflect.Descriptor instead.
+func (*KVCasRequestBody) Descriptor() ([]byte, []int) {
+	return file_proto_kv_kv_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *KVCasRequestBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *KVCasRequestBody) GetKey() int32 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+func (x *KVCasRequestBody) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *KVCasRequestBody) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type KVCasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Src  string             `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dest string             `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	Body *KVCasResponseBody `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (x *KVCasResponse) Reset() {
+	*x = KVCasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_kv_kv_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVCasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVCasResponse) ProtoMessage() {}
+
+func (x *KVCasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_kv_kv_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVCasResponse.ProtoReflect.Descriptor instead.
+func (*KVCasResponse) Descriptor() ([]byte, []int) {
+	return file_proto_kv_kv_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *KVCasResponse) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *KVCasResponse) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (x *KVCasResponse) GetBody() *KVCasResponseBody {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type KVCasResponseBody struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	MsgId     int32  `protobuf:"varint,2,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`
+	InReplyTo int32  `protobuf:"varint,3,opt,name=in_reply_to,json=inReplyTo,proto3" json:"in_reply_to,omitempty"`
+}
+
+func (x *KVCasResponseBody) Reset() {
+	*x = KVCasResponseBody{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_kv_kv_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVCasResponseBody) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVCasResponseBody) ProtoMessage() {}
+
+func (x *KVCasResponseBody) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_kv_kv_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVCasResponseBody.ProtoReflect.Descriptor instead.
+func (*KVCasResponseBody) Descriptor() ([]byte, []int) {
+	return file_proto_kv_kv_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *KVCasResponseBody) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *KVCasResponseBody) GetMsgId() int32 {
+	if x != nil {
+		return x.MsgId
+	}
+	return 0
+}
+
+func (x *KVCasResponseBody) GetInReplyTo() int32 {
+	if x != nil {
+		return x.InReplyTo
+	}
+	return 0
+}
+
+var File_proto_kv_kv_proto protoreflect.FileDescriptor
+
+var file_proto_kv_kv_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6b, 0x76, 0x2f, 0x6b, 0x76, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6b,
+	0x76, 0x22, 0x6a, 0x0a, 0x0d, 0x4b, 0x56, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x73, 0x72, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x6b, 0x76, 0x2e, 0x4b, 0x56, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x39, 0x0a,
+	0x11, 0x4b, 0x56, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f,
+	0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x6c, 0x0a, 0x0e, 0x4b, 0x56, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74,
+	0x12, 0x34, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6b, 0x76, 0x2e, 0x4b, 0x56,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6f, 0x64, 0x79,
+	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x75, 0x0a, 0x12, 0x4b, 0x56, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0b, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x22, 0x6c, 0x0a,
+	0x0e, 0x4b, 0x56, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72,
+	0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x6b, 0x76, 0x2e, 0x4b, 0x56, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x50, 0x0a, 0x12, 0x4b,
+	0x56, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6e, 0x0a,
+	0x0f, 0x4b, 0x56, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73,
+	0x72, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x6b, 0x76, 0x2e, 0x4b, 0x56, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x60, 0x0a,
+	0x13, 0x4b, 0x56, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0b, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x22,
+	0x68, 0x0a, 0x0c, 0x4b, 0x56, 0x43, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72,
+	0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x6b, 0x76, 0x2e, 0x4b, 0x56, 0x43, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x5c, 0x0a, 0x10, 0x4b, 0x56, 0x43,
+	0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x6a, 0x0a, 0x0d, 0x4b, 0x56, 0x43, 0x61, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x33,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d,
+	0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6b, 0x76, 0x2e, 0x4b, 0x56, 0x43, 0x61,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x22, 0x5e, 0x0a, 0x11, 0x4b, 0x56, 0x43, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06,
+	0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x73,
+	0x67, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f,
+	0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x54, 0x6f, 0x32, 0xe6, 0x01, 0x0a, 0x09, 0x4b, 0x56, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x47, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x4b, 0x56, 0x52, 0x65, 0x61, 0x64, 0x12,
+	0x1b, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6b, 0x76, 0x2e, 0x4b,
+	0x56, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d,
+	0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6b, 0x76, 0x2e, 0x4b, 0x56, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x53, 0x65,
+	0x6e, 0x64, 0x4b, 0x56, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x6d, 0x79, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6b, 0x76, 0x2e, 0x4b, 0x56, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x6b, 0x76, 0x2e, 0x4b, 0x56, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x4b, 0x56,
+	0x43, 0x61, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x6b, 0x76, 0x2e, 0x4b, 0x56, 0x43, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6b, 0x76, 0x2e, 0x4b,
+	0x56, 0x43, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x5a, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6b, 0x76, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_kv_kv_proto_rawDescOnce sync.Once
+	file_proto_kv_kv_proto_rawDescData = file_proto_kv_kv_proto_rawDesc
+)
+
+func file_proto_kv_kv_proto_rawDescGZIP() []byte {
+	file_proto_kv_kv_proto_rawDescOnce.Do(func() {
+		file_proto_kv_kv_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_kv_kv_proto_rawDescData)
+	})
+	return file_proto_kv_kv_proto_rawDescData
+}
+
+var file_proto_kv_kv_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_kv_kv_proto_goTypes = []interface{}{
+	(*KVReadRequest)(nil),       // 0: myservice.kv.KVReadRequest
+	(*KVReadRequestBody)(nil),   // 1: myservice.kv.KVReadRequestBody
+	(*KVReadResponse)(nil),      // 2: myservice.kv.KVReadResponse
+	(*KVReadResponseBody)(nil),  // 3: myservice.kv.KVReadResponseBody
+	(*KVWriteRequest)(nil),      // 4: myservice.kv.KVWriteRequest
+	(*KVWriteRequestBody)(nil),  // 5: myservice.kv.KVWriteRequestBody
+	(*KVWriteResponse)(nil),     // 6: myservice.kv.KVWriteResponse
+	(*KVWriteResponseBody)(nil), // 7: myservice.kv.KVWriteResponseBody
+	(*KVCasRequest)(nil),        // 8: myservice.kv.KVCasRequest
+	(*KVCasRequestBody)(nil),    // 9: myservice.kv.KVCasRequestBody
+	(*KVCasResponse)(nil),       // 10: myservice.kv.KVCasResponse
+	(*KVCasResponseBody)(nil),   // 11: myservice.kv.KVCasResponseBody
+}
+var file_proto_kv_kv_proto_depIdxs = []int32{
+	1,  // 0: myservice.kv.KVReadRequest.body:type_name -> myservice.kv.KVReadRequestBody
+	3,  // 1: myservice.kv.KVReadResponse.body:type_name -> myservice.kv.KVReadResponseBody
+	5,  // 2: myservice.kv.KVWriteRequest.body:type_name -> myservice.kv.KVWriteRequestBody
+	7,  // 3: myservice.kv.KVWriteResponse.body:type_name -> myservice.kv.KVWriteResponseBody
+	9,  // 4: myservice.kv.KVCasRequest.body:type_name -> myservice.kv.KVCasRequestBody
+	11, // 5: myservice.kv.KVCasResponse.body:type_name -> myservice.kv.KVCasResponseBody
+	0,  // 6: myservice.kv.KVService.SendKVRead:input_type -> myservice.kv.KVReadRequest
+	4,  // 7: myservice.kv.KVService.SendKVWrite:input_type -> myservice.kv.KVWriteRequest
+	8,  // 8: myservice.kv.KVService.SendKVCas:input_type -> myservice.kv.KVCasRequest
+	2,  // 9: myservice.kv.KVService.SendKVRead:output_type -> myservice.kv.KVReadResponse
+	6,  // 10: myservice.kv.KVService.SendKVWrite:output_type -> myservice.kv.KVWriteResponse
+	10, // 11: myservice.kv.KVService.SendKVCas:output_type -> myservice.kv.KVCasResponse
+	9,  // [9:12] is the sub-list for method output_type
+	6,  // [6:9] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_kv_kv_proto_init() }
+func file_proto_kv_kv_proto_init() {
+	if File_proto_kv_kv_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_kv_kv_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_kv_kv_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVReadRequestBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_kv_kv_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_kv_kv_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVReadResponseBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_kv_kv_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVWriteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_kv_kv_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVWriteRequestBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_kv_kv_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVWriteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_kv_kv_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVWriteResponseBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_kv_kv_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVCasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_kv_kv_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVCasRequestBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_kv_kv_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVCasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_kv_kv_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KVCasResponseBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_kv_kv_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_kv_kv_proto_goTypes,
+		DependencyIndexes: file_proto_kv_kv_proto_depIdxs,
+		MessageInfos:      file_proto_kv_kv_proto_msgTypes,
+	}.Build()
+	File_proto_kv_kv_proto = out.File
+	file_proto_kv_kv_proto_rawDesc = nil
+	file_proto_kv_kv_proto_goTypes = nil
+	file_proto_kv_kv_proto_depIdxs = nil
+}
@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: proto/kv/kv.proto
+
+package kv
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	KVService_SendKVRead_FullMethodName  = "/myservice.kv.KVService/SendKVRead"
+	KVService_SendKVWrite_FullMethodName = "/myservice.kv.KVService/SendKVWrite"
+	KVService_SendKVCas_FullMethodName   = "/myservice.kv.KVService/SendKVCas"
+)
+
+// KVServiceClient is the client API for KVService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KVServiceClient interface {
+	SendKVRead(ctx context.Context, in *KVReadRequest, opts ...grpc.CallOption) (*KVReadResponse, error)
+	SendKVWrite(ctx context.Context, in *KVWriteRequest, opts ...grpc.CallOption) (*KVWriteResponse, error)
+	SendKVCas(ctx context.Context, in *KVCasRequest, opts ...grpc.CallOption) (*KVCasResponse, error)
+}
+
+type kVServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKVServiceClient(cc grpc.ClientConnInterface) KVServiceClient {
+	return &kVServiceClient{cc}
+}
+
+func (c *kVServiceClient) SendKVRead(ctx context.Context, in *KVReadRequest, opts ...grpc.CallOption) (*KVReadResponse, error) {
+	out := new(KVReadResponse)
+	err := c.cc.Invoke(ctx, KVService_SendKVRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kVServiceClient) SendKVWrite(ctx context.Context, in *KVWriteRequest, opts ...grpc.CallOption) (*KVWriteResponse, error) {
+	out := new(KVWriteResponse)
+	err := c.cc.Invoke(ctx, KVService_SendKVWrite_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kVServiceClient) SendKVCas(ctx context.Context, in *KVCasRequest, opts ...grpc.CallOption) (*KVCasResponse, error) {
+	out := new(KVCasResponse)
+	err := c.cc.Invoke(ctx, KVService_SendKVCas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KVServiceServer is the server API for KVService service.
+// All implementations must embed UnimplementedKVServiceServer
+// for forward compatibility
+type KVServiceServer interface {
+	SendKVRead(context.Context, *KVReadRequest) (*KVReadResponse, error)
+	SendKVWrite(context.Context, *KVWriteRequest) (*KVWriteResponse, error)
+	SendKVCas(context.Context, *KVCasRequest) (*KVCasResponse, error)
+	mustEmbedUnimplementedKVServiceServer()
+}
+
+// UnimplementedKVServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedKVServiceServer struct {
+}
+
+func (UnimplementedKVServiceServer) SendKVRead(context.Context, *KVReadRequest) (*KVReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendKVRead not implemented")
+}
+func (UnimplementedKVServiceServer) SendKVWrite(context.Context, *KVWriteRequest) (*KVWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendKVWrite not implemented")
+}
+func (UnimplementedKVServiceServer) SendKVCas(context.Context, *KVCasRequest) (*KVCasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendKVCas not implemented")
+}
+func (UnimplementedKVServiceServer) mustEmbedUnimplementedKVServiceServer() {}
+
+// UnsafeKVServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KVServiceServer will
+// result in compilation errors.
+type UnsafeKVServiceServer interface {
+	mustEmbedUnimplementedKVServiceServer()
+}
+
+func RegisterKVServiceServer(s grpc.ServiceRegistrar, srv KVServiceServer) {
+	s.RegisterService(&KVService_ServiceDesc, srv)
+}
+
+func _KVService_SendKVRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServiceServer).SendKVRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KVService_SendKVRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServiceServer).SendKVRead(ctx, req.(*KVReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KVService_SendKVWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVWriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServiceServer).SendKVWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KVService_SendKVWrite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServiceServer).SendKVWrite(ctx, req.(*KVWriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KVService_SendKVCas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KVCasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KVServiceServer).SendKVCas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KVService_SendKVCas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KVServiceServer).SendKVCas(ctx, req.(*KVCasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KVService_ServiceDesc is the grpc.ServiceDesc for KVService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KVService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "myservice.kv.KVService",
+	HandlerType: (*KVServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendKVRead",
+			Handler:    _KVService_SendKVRead_Handler,
+		},
+		{
+			MethodName: "SendKVWrite",
+			Handler:    _KVService_SendKVWrite_Handler,
+		},
+		{
+			MethodName: "SendKVCas",
+			Handler:    _KVService_SendKVCas_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/kv/kv.proto",
+}
@@ -0,0 +1,350 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.0
+// 	protoc        v5.26.1
+// source: proto/maelstrom/maelstrom.proto
+
+package maelstrom
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The body is either a Struct or the JSON text of an object. Replies use the
+// same form as the request they answer.
+type MessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Src  string `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dest string `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	// Types that are assignable to Payload:
+	//	*MessageRequest_Body
+	//	*MessageRequest_RawBody
+	Payload isMessageRequest_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *MessageRequest) Reset() {
+	*x = MessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_maelstrom_maelstrom_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRequest) ProtoMessage() {}
+
+func (x *MessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_maelstrom_maelstrom_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRequest.ProtoReflect.Descriptor instead.
+func (*MessageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_maelstrom_maelstrom_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MessageRequest) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *MessageRequest) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (m *MessageRequest) GetPayload() isMessageRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *MessageRequest) GetBody() *structpb.Struct {
+	if x, ok := x.GetPayload().(*MessageRequest_Body); ok {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *MessageRequest) GetRawBody() string {
+	if x, ok := x.GetPayload().(*MessageRequest_RawBody); ok {
+		return x.RawBody
+	}
+	return ""
+}
+
+type isMessageRequest_Payload interface {
+	isMessageRequest_Payload()
+}
+
+type MessageRequest_Body struct {
+	Body *structpb.Struct `protobuf:"bytes,3,opt,name=body,proto3,oneof"`
+}
+
+type MessageRequest_RawBody struct {
+	RawBody string `protobuf:"bytes,4,opt,name=raw_body,json=rawBody,proto3,oneof"`
+}
+
+func (*MessageRequest_Body) isMessageRequest_Payload() {}
+
+func (*MessageRequest_RawBody) isMessageRequest_Payload() {}
+
+type MessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Src  string `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	Dest string `protobuf:"bytes,2,opt,name=dest,proto3" json:"dest,omitempty"`
+	// Types that are assignable to Payload:
+	//	*MessageResponse_Body
+	//	*MessageResponse_RawBody
+	Payload isMessageResponse_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *MessageResponse) Reset() {
+	*x = MessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_maelstrom_maelstrom_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageResponse) ProtoMessage() {}
+
+func (x *MessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_maelstrom_maelstrom_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageResponse.ProtoReflect.Descriptor instead.
+func (*MessageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_maelstrom_maelstrom_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MessageResponse) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *MessageResponse) GetDest() string {
+	if x != nil {
+		return x.Dest
+	}
+	return ""
+}
+
+func (m *MessageResponse) GetPayload() isMessageResponse_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *MessageResponse) GetBody() *structpb.Struct {
+	if x, ok := x.GetPayload().(*MessageResponse_Body); ok {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *MessageResponse) GetRawBody() string {
+	if x, ok := x.GetPayload().(*MessageResponse_RawBody); ok {
+		return x.RawBody
+	}
+	return ""
+}
+
+type isMessageResponse_Payload interface {
+	isMessageResponse_Payload()
+}
+
+type MessageResponse_Body struct {
+	Body *structpb.Struct `protobuf:"bytes,3,opt,name=body,proto3,oneof"`
+}
+
+type MessageResponse_RawBody struct {
+	RawBody string `protobuf:"bytes,4,opt,name=raw_body,json=rawBody,proto3,oneof"`
+}
+
+func (*MessageResponse_Body) isMessageResponse_Payload() {}
+
+func (*MessageResponse_RawBody) isMessageResponse_Payload() {}
+
+var File_proto_maelstrom_maelstrom_proto protoreflect.FileDescriptor
+
+var file_proto_maelstrom_maelstrom_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x61, 0x65, 0x6c, 0x73, 0x74, 0x72, 0x6f,
+	0x6d, 0x2f, 0x6d, 0x61, 0x65, 0x6c, 0x73, 0x74, 0x72, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x13, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x61, 0x65,
+	0x6c, 0x73, 0x74, 0x72, 0x6f, 0x6d, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8d, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1b, 0x0a, 0x08,
+	0x72, 0x61, 0x77, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x07, 0x72, 0x61, 0x77, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x72, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x72, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x74, 0x12, 0x2d,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1b, 0x0a,
+	0x08, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x07, 0x72, 0x61, 0x77, 0x42, 0x6f, 0x64, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x32, 0xc3, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x23, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x6d, 0x61, 0x65, 0x6c, 0x73, 0x74, 0x72, 0x6f, 0x6d, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d,
+	0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x61, 0x65, 0x6c, 0x73, 0x74, 0x72,
+	0x6f, 0x6d, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x57, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x23, 0x2e, 0x6d,
+	0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x61, 0x65, 0x6c, 0x73, 0x74, 0x72,
+	0x6f, 0x6d, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x79, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6d, 0x61,
+	0x65, 0x6c, 0x73, 0x74, 0x72, 0x6f, 0x6d, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x42, 0x11, 0x5a, 0x0f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x61, 0x65, 0x6c, 0x73, 0x74, 0x72, 0x6f, 0x6d, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_maelstrom_maelstrom_proto_rawDescOnce sync.Once
+	file_proto_maelstrom_maelstrom_proto_rawDescData = file_proto_maelstrom_maelstrom_proto_rawDesc
+)
+
+func file_proto_maelstrom_maelstrom_proto_rawDescGZIP() []byte {
+	file_proto_maelstrom_maelstrom_proto_rawDescOnce.Do(func() {
+		file_proto_maelstrom_maelstrom_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_maelstrom_maelstrom_proto_rawDescData)
+	})
+	return file_proto_maelstrom_maelstrom_proto_rawDescData
+}
+
+var file_proto_maelstrom_maelstrom_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_maelstrom_maelstrom_proto_goTypes = []interface{}{
+	(*MessageRequest)(nil),  // 0: myservice.maelstrom.MessageRequest
+	(*MessageResponse)(nil), // 1: myservice.maelstrom.MessageResponse
+	(*structpb.Struct)(nil), // 2: google.protobuf.Struct
+}
+var file_proto_maelstrom_maelstrom_proto_depIdxs = []int32{
+	2, // 0: myservice.maelstrom.MessageRequest.body:type_name -> google.protobuf.Struct
+	2, // 1: myservice.maelstrom.MessageResponse.body:type_name -> google.protobuf.Struct
+	0, // 2: myservice.maelstrom.MessageService.SendMessage:input_type -> myservice.maelstrom.MessageRequest
+	0, // 3: myservice.maelstrom.MessageService.Stream:input_type -> myservice.maelstrom.MessageRequest
+	1, // 4: myservice.maelstrom.MessageService.SendMessage:output_type -> myservice.maelstrom.MessageResponse
+	1, // 5: myservice.maelstrom.MessageService.Stream:output_type -> myservice.maelstrom.MessageResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_maelstrom_maelstrom_proto_init() }
+func file_proto_maelstrom_maelstrom_proto_init() {
+	if File_proto_maelstrom_maelstrom_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_maelstrom_maelstrom_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_maelstrom_maelstrom_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_maelstrom_maelstrom_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*MessageRequest_Body)(nil),
+		(*MessageRequest_RawBody)(nil),
+	}
+	file_proto_maelstrom_maelstrom_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*MessageResponse_Body)(nil),
+		(*MessageResponse_RawBody)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_maelstrom_maelstrom_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_maelstrom_maelstrom_proto_goTypes,
+		DependencyIndexes: file_proto_maelstrom_maelstrom_proto_depIdxs,
+		MessageInfos:      file_proto_maelstrom_maelstrom_proto_msgTypes,
+	}.Build()
+	File_proto_maelstrom_maelstrom_proto = out.File
+	file_proto_maelstrom_maelstrom_proto_rawDesc = nil
+	file_proto_maelstrom_maelstrom_proto_goTypes = nil
+	file_proto_maelstrom_maelstrom_proto_depIdxs = nil
+}
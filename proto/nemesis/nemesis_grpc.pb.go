@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: proto/nemesis/nemesis.proto
+
+package nemesis
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	NemesisService_Partition_FullMethodName = "/myservice.nemesis.NemesisService/Partition"
+	NemesisService_Heal_FullMethodName      = "/myservice.nemesis.NemesisService/Heal"
+	NemesisService_SetFaults_FullMethodName = "/myservice.nemesis.NemesisService/SetFaults"
+	NemesisService_GetFaults_FullMethodName = "/myservice.nemesis.NemesisService/GetFaults"
+	NemesisService_SetClock_FullMethodName  = "/myservice.nemesis.NemesisService/SetClock"
+)
+
+// NemesisServiceClient is the client API for NemesisService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NemesisServiceClient interface {
+	Partition(ctx context.Context, in *PartitionRequest, opts ...grpc.CallOption) (*PartitionResponse, error)
+	Heal(ctx context.Context, in *HealRequest, opts ...grpc.CallOption) (*HealResponse, error)
+	SetFaults(ctx context.Context, in *SetFaultsRequest, opts ...grpc.CallOption) (*SetFaultsResponse, error)
+	GetFaults(ctx context.Context, in *GetFaultsRequest, opts ...grpc.CallOption) (*GetFaultsResponse, error)
+	SetClock(ctx context.Context, in *SetClockRequest, opts ...grpc.CallOption) (*SetClockResponse, error)
+}
+
+type nemesisServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNemesisServiceClient(cc grpc.ClientConnInterface) NemesisServiceClient {
+	return &nemesisServiceClient{cc}
+}
+
+func (c *nemesisServiceClient) Partition(ctx context.Context, in *PartitionRequest, opts ...grpc.CallOption) (*PartitionResponse, error) {
+	out := new(PartitionResponse)
+	err := c.cc.Invoke(ctx, NemesisService_Partition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nemesisServiceClient) Heal(ctx context.Context, in *HealRequest, opts ...grpc.CallOption) (*HealResponse, error) {
+	out := new(HealResponse)
+	err := c.cc.Invoke(ctx, NemesisService_Heal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nemesisServiceClient) SetFaults(ctx context.Context, in *SetFaultsRequest, opts ...grpc.CallOption) (*SetFaultsResponse, error) {
+	out := new(SetFaultsResponse)
+	err := c.cc.Invoke(ctx, NemesisService_SetFaults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nemesisServiceClient) GetFaults(ctx context.Context, in *GetFaultsRequest, opts ...grpc.CallOption) (*GetFaultsResponse, error) {
+	out := new(GetFaultsResponse)
+	err := c.cc.Invoke(ctx, NemesisService_GetFaults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nemesisServiceClient) SetClock(ctx context.Context, in *SetClockRequest, opts ...grpc.CallOption) (*SetClockResponse, error) {
+	out := new(SetClockResponse)
+	err := c.cc.Invoke(ctx, NemesisService_SetClock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NemesisServiceServer is the server API for NemesisService service.
+// All implementations must embed UnimplementedNemesisServiceServer
+// for forward compatibility
+type NemesisServiceServer interface {
+	Partition(context.Context, *PartitionRequest) (*PartitionResponse, error)
+	Heal(context.Context, *HealRequest) (*HealResponse, error)
+	SetFaults(context.Context, *SetFaultsRequest) (*SetFaultsResponse, error)
+	GetFaults(context.Context, *GetFaultsRequest) (*GetFaultsResponse, error)
+	SetClock(context.Context, *SetClockRequest) (*SetClockResponse, error)
+	mustEmbedUnimplementedNemesisServiceServer()
+}
+
+// UnimplementedNemesisServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedNemesisServiceServer struct {
+}
+
+func (UnimplementedNemesisServiceServer) Partition(context.Context, *PartitionRequest) (*PartitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Partition not implemented")
+}
+func (UnimplementedNemesisServiceServer) Heal(context.Context, *HealRequest) (*HealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Heal not implemented")
+}
+func (UnimplementedNemesisServiceServer) SetFaults(context.Context, *SetFaultsRequest) (*SetFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFaults not implemented")
+}
+func (UnimplementedNemesisServiceServer) GetFaults(context.Context, *GetFaultsRequest) (*GetFaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFaults not implemented")
+}
+func (UnimplementedNemesisServiceServer) SetClock(context.Context, *SetClockRequest) (*SetClockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClock not implemented")
+}
+func (UnimplementedNemesisServiceServer) mustEmbedUnimplementedNemesisServiceServer() {}
+
+// UnsafeNemesisServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NemesisServiceServer will
+// result in compilation errors.
+type UnsafeNemesisServiceServer interface {
+	mustEmbedUnimplementedNemesisServiceServer()
+}
+
+func RegisterNemesisServiceServer(s grpc.ServiceRegistrar, srv NemesisServiceServer) {
+	s.RegisterService(&NemesisService_ServiceDesc, srv)
+}
+
+func _NemesisService_Partition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NemesisServiceServer).Partition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NemesisService_Partition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NemesisServiceServer).Partition(ctx, req.(*PartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NemesisService_Heal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HealRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NemesisServiceServer).Heal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NemesisService_Heal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NemesisServiceServer).Heal(ctx, req.(*HealRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NemesisService_SetFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NemesisServiceServer).SetFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NemesisService_SetFaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NemesisServiceServer).SetFaults(ctx, req.(*SetFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NemesisService_GetFaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NemesisServiceServer).GetFaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NemesisService_GetFaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NemesisServiceServer).GetFaults(ctx, req.(*GetFaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NemesisService_SetClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetClockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NemesisServiceServer).SetClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NemesisService_SetClock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NemesisServiceServer).SetClock(ctx, req.(*SetClockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NemesisService_ServiceDesc is the grpc.ServiceDesc for NemesisService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NemesisService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "myservice.nemesis.NemesisService",
+	HandlerType: (*NemesisServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Partition",
+			Handler:    _NemesisService_Partition_Handler,
+		},
+		{
+			MethodName: "Heal",
+			Handler:    _NemesisService_Heal_Handler,
+		},
+		{
+			MethodName: "SetFaults",
+			Handler:    _NemesisService_SetFaults_Handler,
+		},
+		{
+			MethodName: "GetFaults",
+			Handler:    _NemesisService_GetFaults_Handler,
+		},
+		{
+			MethodName: "SetClock",
+			Handler:    _NemesisService_SetClock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/nemesis/nemesis.proto",
+}